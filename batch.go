@@ -0,0 +1,148 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/utils"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+const (
+	defaultBatchMaxCalls         = 50
+	defaultBatchMaxCalldataBytes = 64 * 1024
+)
+
+// BatchOptions configures ExecuteBatched.
+type BatchOptions struct {
+	// MaxCallsPerChunk limits the number of calls packed into one relayed transaction. Defaults to 50.
+	MaxCallsPerChunk int
+	// MaxCalldataBytes limits the total decoded calldata of a chunk. Defaults to 64 KiB.
+	// A single call larger than the limit is sent in a chunk of its own.
+	MaxCalldataBytes int
+	// Wait configures how long each chunk is polled before the next one is submitted.
+	Wait WaitOptions
+}
+
+// ChunkStatus is the outcome of one chunk of a batched execution.
+type ChunkStatus string
+
+const (
+	ChunkSucceeded ChunkStatus = "SUCCEEDED"
+	ChunkFailed    ChunkStatus = "FAILED"
+	ChunkTimedOut  ChunkStatus = "TIMED_OUT"
+	// ChunkSkipped marks chunks that were not submitted because an earlier chunk did not succeed.
+	ChunkSkipped ChunkStatus = "SKIPPED"
+)
+
+// ChunkResult reports the outcome of one relayed chunk.
+type ChunkResult struct {
+	Index int
+	// Start and End delimit the chunk's calls in the input slice as txns[Start:End].
+	Start       int
+	End         int
+	Status      ChunkStatus
+	Response    *ClientRelayerTransactionResponse
+	Transaction *types.RelayerTransaction
+	Err         error
+}
+
+// BatchResult aggregates the chunk results of ExecuteBatched.
+type BatchResult struct {
+	Chunks []ChunkResult
+}
+
+// Succeeded reports whether every chunk was mined or confirmed.
+func (r *BatchResult) Succeeded() bool {
+	for _, chunk := range r.Chunks {
+		if chunk.Status != ChunkSucceeded {
+			return false
+		}
+	}
+	return len(r.Chunks) > 0
+}
+
+// ExecuteBatched splits txns into chunks by call count and calldata size and relays them one
+// after another in nonce order. Each chunk is waited for before the next is submitted, so a
+// failed or timed-out chunk stops the run and the remaining chunks are reported as skipped.
+// The returned error is only set when the batch could not be planned.
+func (c *RelayClient) ExecuteBatched(ctx context.Context, txns []types.Transaction, metadata string, opts BatchOptions) (*BatchResult, error) {
+	if c.signer == nil {
+		return nil, types.ErrSignerUnavailable
+	}
+	if len(txns) == 0 {
+		return nil, types.ErrNoTransactions
+	}
+	switch c.relayTxType {
+	case types.RelayerTxSafe, types.RelayerTxProxy:
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrUnsupportedTxType, c.relayTxType)
+	}
+
+	ranges, err := chunkTransactions(txns, opts.MaxCallsPerChunk, opts.MaxCalldataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResult{Chunks: make([]ChunkResult, len(ranges))}
+	for i, r := range ranges {
+		result.Chunks[i] = ChunkResult{Index: i, Start: r[0], End: r[1], Status: ChunkSkipped}
+	}
+
+	for i := range result.Chunks {
+		chunk := &result.Chunks[i]
+		chunkTxns := txns[chunk.Start:chunk.End]
+
+		chunk.Response, err = c.prepareAndSubmit(ctx, func(ctx context.Context) (*PreparedTransaction, error) {
+			if c.relayTxType == types.RelayerTxSafe {
+				return c.prepareSafeTransactions(ctx, toSafeTransactions(chunkTxns), metadata, true)
+			}
+			return c.prepareProxyTransactions(ctx, toProxyTransactions(chunkTxns), metadata)
+		})
+		if err != nil {
+			chunk.Status, chunk.Err = ChunkFailed, err
+			break
+		}
+
+		chunk.Transaction, err = chunk.Response.WaitWithOptions(ctx, opts.Wait)
+		if err != nil {
+			chunk.Err = err
+			if errors.Is(err, types.ErrTransactionTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				chunk.Status = ChunkTimedOut
+			} else {
+				chunk.Status = ChunkFailed
+			}
+			break
+		}
+		chunk.Status = ChunkSucceeded
+	}
+	return result, nil
+}
+
+// chunkTransactions returns [start, end) ranges of txns honouring the call and calldata limits.
+func chunkTransactions(txns []types.Transaction, maxCalls, maxBytes int) ([][2]int, error) {
+	if maxCalls <= 0 {
+		maxCalls = defaultBatchMaxCalls
+	}
+	if maxBytes <= 0 {
+		maxBytes = defaultBatchMaxCalldataBytes
+	}
+
+	var ranges [][2]int
+	start, size := 0, 0
+	for i, tx := range txns {
+		data, err := utils.DecodeHex(tx.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data in transaction %d: %w", i, err)
+		}
+		calls := i - start
+		if calls > 0 && (calls >= maxCalls || size+len(data) > maxBytes) {
+			ranges = append(ranges, [2]int{start, i})
+			start, size = i, 0
+		}
+		size += len(data)
+	}
+	ranges = append(ranges, [2]int{start, len(txns)})
+	return ranges, nil
+}
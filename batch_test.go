@@ -0,0 +1,67 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestChunkTransactions_SplitsByCountAndSize(t *testing.T) {
+	t.Parallel()
+
+	small := types.Transaction{To: types.ZeroAddress, Data: "0x" + strings.Repeat("aa", 10)}
+	large := types.Transaction{To: types.ZeroAddress, Data: "0x" + strings.Repeat("bb", 100)}
+
+	ranges, err := chunkTransactions([]types.Transaction{small, small, small, small, small}, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, [][2]int{{0, 2}, {2, 4}, {4, 5}}, ranges)
+
+	ranges, err = chunkTransactions([]types.Transaction{small, small, large, small}, 10, 50)
+	require.NoError(t, err)
+	assert.Equal(t, [][2]int{{0, 2}, {2, 3}, {3, 4}}, ranges)
+
+	_, err = chunkTransactions([]types.Transaction{{Data: "0xzz"}}, 0, 0)
+	require.Error(t, err)
+}
+
+func TestExecuteBatched_StopsAfterFailedChunk(t *testing.T) {
+	t.Parallel()
+
+	submits := 0
+	client, _ := newFakeRelayer(t, map[string]http.HandlerFunc{
+		GetNonceEndpoint: respond(http.StatusOK, `{"nonce":"1"}`),
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			submits++
+			if submits == 2 {
+				respond(http.StatusBadRequest, `{"error":"invalid nonce"}`)(w, r)
+				return
+			}
+			respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`)(w, r)
+		},
+		GetTransactionEndpoint: respond(http.StatusOK, `[{"transactionID":"tx-1","state":"STATE_MINED"}]`),
+	})
+
+	txns := make([]types.Transaction, 5)
+	for i := range txns {
+		txns[i] = types.Transaction{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}
+	}
+
+	result, err := client.ExecuteBatched(context.Background(), txns, "redeem", BatchOptions{MaxCallsPerChunk: 2})
+	require.NoError(t, err)
+	require.Len(t, result.Chunks, 3)
+	assert.False(t, result.Succeeded())
+
+	assert.Equal(t, ChunkSucceeded, result.Chunks[0].Status)
+	assert.Equal(t, types.StateMined, result.Chunks[0].Transaction.State)
+	assert.Equal(t, ChunkFailed, result.Chunks[1].Status)
+	assert.ErrorIs(t, result.Chunks[1].Err, types.ErrNonceMismatch)
+	assert.Equal(t, ChunkSkipped, result.Chunks[2].Status)
+	assert.Equal(t, 4, result.Chunks[2].Start)
+	assert.Equal(t, 2, submits)
+}
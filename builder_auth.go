@@ -20,6 +20,17 @@ var (
 	defaultRemoteClient     *http.Client
 )
 
+type remoteSignerClientContextKey struct{}
+
+// contextWithRemoteSignerClient makes client the HTTP client of every remote signer reached
+// with ctx that does not set its own, however it is wrapped in BuilderConfig.Signer.
+func contextWithRemoteSignerClient(ctx context.Context, client BuilderHTTPDoer) context.Context {
+	if client == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteSignerClientContextKey{}, client)
+}
+
 func getDefaultRemoteClient() *http.Client {
 	defaultRemoteClientOnce.Do(func() {
 		defaultRemoteClient = &http.Client{Timeout: 10 * time.Second}
@@ -50,9 +61,9 @@ func normalizeBuilderTimestamp(timestamp int64) int64 {
 
 // BuilderCredentials represents builder attribution credentials.
 type BuilderCredentials struct {
-	Key        string
-	Secret     string
-	Passphrase string
+	Key        string `json:"key"`
+	Secret     string `json:"secret"`
+	Passphrase string `json:"passphrase"`
 }
 
 // BuilderHTTPDoer executes HTTP requests for remote signing.
@@ -73,6 +84,8 @@ type BuilderConfig struct {
 	Remote *BuilderRemoteConfig
 	// RemoteFallbackLocal enables remote-first signing with local fallback when both configs are provided.
 	RemoteFallbackLocal bool
+	// Signer, when set, produces the headers instead of Local and Remote.
+	Signer BuilderSigner
 }
 
 // IsValid returns true if the configuration has sufficient credentials.
@@ -80,6 +93,9 @@ func (c *BuilderConfig) IsValid() bool {
 	if c == nil {
 		return false
 	}
+	if c.Signer != nil {
+		return true
+	}
 	localValid := c.Local != nil && c.Local.Key != "" && c.Local.Secret != "" && c.Local.Passphrase != ""
 	remoteValid := c.Remote != nil && c.Remote.Host != ""
 
@@ -100,26 +116,39 @@ func (c *BuilderConfig) Headers(ctx context.Context, method, path string, body *
 	if c == nil {
 		return nil, types.ErrMissingBuilderConfig
 	}
+	if c.Signer != nil {
+		return c.Signer.Headers(ctx, method, path, body, timestamp)
+	}
 	if c.RemoteFallbackLocal && c.Remote != nil && c.Local != nil {
-		headers, remoteErr := buildBuilderHeadersRemote(ctx, c.Remote, method, path, body, timestamp)
+		headers, remoteErr := c.Remote.Headers(ctx, method, path, body, timestamp)
 		if remoteErr == nil {
 			return headers, nil
 		}
-		headers, localErr := buildBuilderHeadersLocal(c.Local, method, path, body, timestamp)
+		headers, localErr := c.Local.Headers(ctx, method, path, body, timestamp)
 		if localErr == nil {
 			return headers, nil
 		}
 		return nil, fmt.Errorf("builder remote fallback local failed: remote=%v local=%v", remoteErr, localErr)
 	}
 	if c.Local != nil {
-		return buildBuilderHeadersLocal(c.Local, method, path, body, timestamp)
+		return c.Local.Headers(ctx, method, path, body, timestamp)
 	}
 	if c.Remote != nil {
-		return buildBuilderHeadersRemote(ctx, c.Remote, method, path, body, timestamp)
+		return c.Remote.Headers(ctx, method, path, body, timestamp)
 	}
 	return nil, types.ErrMissingBuilderConfig
 }
 
+// Headers signs the request locally with HMAC-SHA256.
+func (c *BuilderCredentials) Headers(_ context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	return buildBuilderHeadersLocal(c, method, path, body, timestamp)
+}
+
+// Headers asks the remote signing service for the headers.
+func (c *BuilderRemoteConfig) Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	return buildBuilderHeadersRemote(ctx, c, method, path, body, timestamp)
+}
+
 func buildBuilderHeadersLocal(creds *BuilderCredentials, method, path string, body *string, timestamp int64) (http.Header, error) {
 	if creds == nil || creds.Key == "" || creds.Secret == "" || creds.Passphrase == "" {
 		return nil, types.ErrMissingBuilderConfig
@@ -180,6 +209,9 @@ func buildBuilderHeadersRemote(ctx context.Context, remote *BuilderRemoteConfig,
 	}
 
 	client := remote.HTTPClient
+	if client == nil {
+		client, _ = ctx.Value(remoteSignerClientContextKey{}).(BuilderHTTPDoer)
+	}
 	if client == nil {
 		client = getDefaultRemoteClient()
 	}
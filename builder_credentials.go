@@ -0,0 +1,199 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/logger"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// BuilderCredentialsProvider supplies the current builder credentials, so that keys can be
+// rotated without restarting the client. It must be safe for concurrent use. Sign with
+// provided credentials through ProviderBuilderSigner.
+type BuilderCredentialsProvider interface {
+	Credentials(ctx context.Context) (*BuilderCredentials, error)
+}
+
+// RefreshableBuilderSigner is a BuilderSigner that can reload its credentials on demand.
+// When the relayer answers 401, RelayClient refreshes BuilderConfig.Signer and retries
+// once if the API key changed. FallbackBuilderSigner, RoundRobinBuilderSigner and
+// CachingBuilderSigner pass Refresh on to the signers they wrap.
+type RefreshableBuilderSigner interface {
+	BuilderSigner
+	Refresh(ctx context.Context) error
+}
+
+var (
+	_ RefreshableBuilderSigner = ProviderBuilderSigner{}
+	_ RefreshableBuilderSigner = FallbackBuilderSigner(nil)
+	_ RefreshableBuilderSigner = (*RoundRobinBuilderSigner)(nil)
+	_ RefreshableBuilderSigner = (*CachingBuilderSigner)(nil)
+)
+
+// ProviderBuilderSigner signs locally with the credentials of Provider at the time of each
+// request. Requests already signed keep the key they were signed with.
+type ProviderBuilderSigner struct {
+	Provider BuilderCredentialsProvider
+}
+
+// Headers implements BuilderSigner.
+func (s ProviderBuilderSigner) Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	if s.Provider == nil {
+		return nil, types.ErrMissingBuilderConfig
+	}
+	creds, err := s.Provider.Credentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("builder credentials: %w", err)
+	}
+	return creds.Headers(ctx, method, path, body, timestamp)
+}
+
+// Refresh implements RefreshableBuilderSigner by refreshing the provider if it supports it.
+func (s ProviderBuilderSigner) Refresh(ctx context.Context) error {
+	if r, ok := s.Provider.(interface{ Refresh(context.Context) error }); ok {
+		return r.Refresh(ctx)
+	}
+	return nil
+}
+
+// AtomicBuilderCredentials holds credentials that can be replaced at any time with Store,
+// e.g. from a secret manager's rotation callback.
+type AtomicBuilderCredentials struct {
+	current atomic.Pointer[BuilderCredentials]
+}
+
+// NewAtomicBuilderCredentials creates an AtomicBuilderCredentials holding creds.
+func NewAtomicBuilderCredentials(creds *BuilderCredentials) *AtomicBuilderCredentials {
+	a := &AtomicBuilderCredentials{}
+	a.Store(creds)
+	return a
+}
+
+// Store replaces the credentials for every request signed from now on. creds is copied.
+func (a *AtomicBuilderCredentials) Store(creds *BuilderCredentials) {
+	if creds == nil {
+		a.current.Store(nil)
+		return
+	}
+	stored := *creds
+	a.current.Store(&stored)
+}
+
+// Credentials implements BuilderCredentialsProvider.
+func (a *AtomicBuilderCredentials) Credentials(context.Context) (*BuilderCredentials, error) {
+	creds := a.current.Load()
+	if creds == nil {
+		return nil, types.ErrMissingBuilderConfig
+	}
+	copied := *creds
+	return &copied, nil
+}
+
+// BuilderCredentialsFunc adapts a function, called for every request, to a
+// BuilderCredentialsProvider.
+type BuilderCredentialsFunc func(ctx context.Context) (*BuilderCredentials, error)
+
+// Credentials implements BuilderCredentialsProvider.
+func (f BuilderCredentialsFunc) Credentials(ctx context.Context) (*BuilderCredentials, error) {
+	return f(ctx)
+}
+
+// FileBuilderCredentials reads credentials from a JSON file such as
+//
+//	{"key": "...", "secret": "...", "passphrase": "..."}
+//
+// and reloads it when its modification time or size changes, checking at most once per
+// interval. If a reload fails the previous credentials stay in use.
+type FileBuilderCredentials struct {
+	path     string
+	interval time.Duration
+	now      func() time.Time
+	logger   logger.Logger
+
+	mu        sync.Mutex
+	creds     BuilderCredentials
+	modTime   time.Time
+	size      int64
+	checkedAt time.Time
+}
+
+// FileBuilderCredentialsOption configures a FileBuilderCredentials.
+type FileBuilderCredentialsOption func(*FileBuilderCredentials)
+
+// WithFileCredentialsLogger sets the logger for failed reloads. Defaults to logger.GetDefault().
+func WithFileCredentialsLogger(l logger.Logger) FileBuilderCredentialsOption {
+	return func(f *FileBuilderCredentials) { f.logger = l }
+}
+
+// NewFileBuilderCredentials loads the credentials file at path. A zero interval checks
+// the file on every request.
+func NewFileBuilderCredentials(path string, interval time.Duration, opts ...FileBuilderCredentialsOption) (*FileBuilderCredentials, error) {
+	f := &FileBuilderCredentials{path: path, interval: interval, now: time.Now}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if err := f.Refresh(context.Background()); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Credentials implements BuilderCredentialsProvider.
+func (f *FileBuilderCredentials) Credentials(context.Context) (*BuilderCredentials, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if now := f.now(); now.Sub(f.checkedAt) >= f.interval {
+		f.checkedAt = now
+		if info, err := os.Stat(f.path); err != nil {
+			f.log().Warn("builder credentials: keeping previous credentials: %v", err)
+		} else if !info.ModTime().Equal(f.modTime) || info.Size() != f.size {
+			if err := f.reload(); err != nil {
+				f.log().Warn("builder credentials: keeping previous credentials: %v", err)
+			}
+		}
+	}
+	creds := f.creds
+	return &creds, nil
+}
+
+// Refresh reloads the file now.
+func (f *FileBuilderCredentials) Refresh(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checkedAt = f.now()
+	return f.reload()
+}
+
+func (f *FileBuilderCredentials) log() logger.Logger {
+	if f.logger != nil {
+		return f.logger
+	}
+	return logger.GetDefault()
+}
+
+func (f *FileBuilderCredentials) reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("read builder credentials: %w", err)
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("read builder credentials: %w", err)
+	}
+	var creds BuilderCredentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return fmt.Errorf("decode builder credentials %s: %w", f.path, err)
+	}
+	if creds.Key == "" || creds.Secret == "" || creds.Passphrase == "" {
+		return fmt.Errorf("%w: %s needs key, secret and passphrase", types.ErrMissingBuilderConfig, f.path)
+	}
+	f.creds, f.modTime, f.size = creds, info.ModTime(), info.Size()
+	return nil
+}
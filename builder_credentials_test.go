@@ -0,0 +1,191 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func newRotationTestClient(t *testing.T, signer BuilderSigner, transport roundTripFunc) *RelayClient {
+	t.Helper()
+	client, err := NewRelayClientWithOptions("https://example.test", 137,
+		WithBuilderConfig(&BuilderConfig{Signer: signer}),
+		WithHTTPClient(NewHTTPClient(&http.Client{Transport: transport})),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func writeBuilderCredentials(t *testing.T, path, key string) {
+	t.Helper()
+	data := `{"key":"` + key + `","secret":"c2VjcmV0","passphrase":"pass"}`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+}
+
+func TestAtomicBuilderCredentials_InFlightRequestKeepsOldKey(t *testing.T) {
+	t.Parallel()
+
+	creds := NewAtomicBuilderCredentials(&BuilderCredentials{Key: "old-key", Secret: "c2VjcmV0", Passphrase: "pass"})
+	var keys []string
+	client := newRotationTestClient(t, ProviderBuilderSigner{Provider: creds}, func(req *http.Request) (*http.Response, error) {
+		keys = append(keys, req.Header.Get(HeaderPolyBuilderAPIKey))
+		// Rotate while the first request is in flight.
+		creds.Store(&BuilderCredentials{Key: "new-key", Secret: "c2VjcmV0", Passphrase: "pass"})
+		return newResponse(http.StatusOK, `[]`, nil), nil
+	})
+
+	_, err := client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	_, err = client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-key", "new-key"}, keys)
+}
+
+func TestSend_RetriesWithRotatedKeyOn401(t *testing.T) {
+	t.Parallel()
+
+	creds := NewAtomicBuilderCredentials(&BuilderCredentials{Key: "old-key", Secret: "c2VjcmV0", Passphrase: "pass"})
+	var keys []string
+	client := newRotationTestClient(t, ProviderBuilderSigner{Provider: creds}, func(req *http.Request) (*http.Response, error) {
+		key := req.Header.Get(HeaderPolyBuilderAPIKey)
+		keys = append(keys, key)
+		if key == "old-key" {
+			// The key was revoked while the request was in flight.
+			creds.Store(&BuilderCredentials{Key: "new-key", Secret: "c2VjcmV0", Passphrase: "pass"})
+			return newResponse(http.StatusUnauthorized, `{"error":"invalid api key"}`, nil), nil
+		}
+		return newResponse(http.StatusOK, `[]`, nil), nil
+	})
+
+	_, err := client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-key", "new-key"}, keys)
+}
+
+func TestSend_DoesNotRetry401WithSameKey(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	creds := NewAtomicBuilderCredentials(&BuilderCredentials{Key: "key", Secret: "c2VjcmV0", Passphrase: "pass"})
+	client := newRotationTestClient(t, ProviderBuilderSigner{Provider: creds}, func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return newResponse(http.StatusUnauthorized, `{"error":"invalid api key"}`, nil), nil
+	})
+
+	_, err := client.GetTransactions(context.Background())
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestFileBuilderCredentials_ReloadsOnChange(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "builder.json")
+	writeBuilderCredentials(t, path, "key-1")
+	log := &recordingLogger{}
+	provider, err := NewFileBuilderCredentials(path, 0, WithFileCredentialsLogger(log))
+	require.NoError(t, err)
+
+	creds, err := provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", creds.Key)
+
+	writeBuilderCredentials(t, path, "key-two")
+	creds, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key-two", creds.Key)
+
+	// A broken file keeps the previous credentials.
+	require.NoError(t, os.WriteFile(path, []byte(`{"key":`), 0o600))
+	creds, err = provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key-two", creds.Key)
+	assert.Len(t, log.warnings, 1)
+	assert.Error(t, provider.Refresh(context.Background()))
+
+	_, err = NewFileBuilderCredentials(filepath.Join(t.TempDir(), "missing.json"), time.Second)
+	assert.Error(t, err)
+}
+
+func TestFileBuilderCredentials_RefreshedOn401(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "builder.json")
+	writeBuilderCredentials(t, path, "old-key")
+	provider, err := NewFileBuilderCredentials(path, time.Hour)
+	require.NoError(t, err)
+
+	var keys []string
+	client := newRotationTestClient(t, ProviderBuilderSigner{Provider: provider}, func(req *http.Request) (*http.Response, error) {
+		key := req.Header.Get(HeaderPolyBuilderAPIKey)
+		keys = append(keys, key)
+		if key == "old-key" {
+			return newResponse(http.StatusUnauthorized, `{"error":"invalid api key"}`, nil), nil
+		}
+		return newResponse(http.StatusOK, `[]`, nil), nil
+	})
+
+	// The file is rotated but not re-checked for an hour; the 401 forces a reload.
+	writeBuilderCredentials(t, path, "new-key")
+	_, err = client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-key", "new-key"}, keys)
+}
+
+func TestBuilderCredentialsFunc(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	signer := ProviderBuilderSigner{Provider: BuilderCredentialsFunc(func(context.Context) (*BuilderCredentials, error) {
+		calls++
+		return &BuilderCredentials{Key: "callback-key", Secret: "c2VjcmV0", Passphrase: "pass"}, nil
+	})}
+	headers, err := signer.Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "callback-key", headers.Get(HeaderPolyBuilderAPIKey))
+	assert.Equal(t, 1, calls)
+
+	_, err = ProviderBuilderSigner{Provider: NewAtomicBuilderCredentials(nil)}.Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+	assert.ErrorIs(t, err, types.ErrMissingBuilderConfig)
+}
+
+func TestSend_RetriesWithRotatedKeyThroughWrappers(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "builder.json")
+	writeBuilderCredentials(t, path, "old-key")
+	provider, err := NewFileBuilderCredentials(path, time.Hour)
+	require.NoError(t, err)
+	signer := NewCachingBuilderSigner(FallbackBuilderSigner{
+		NewRoundRobinBuilderSigner(ProviderBuilderSigner{Provider: provider}),
+	}, time.Minute)
+
+	var keys []string
+	client := newRotationTestClient(t, signer, func(req *http.Request) (*http.Response, error) {
+		key := req.Header.Get(HeaderPolyBuilderAPIKey)
+		keys = append(keys, key)
+		if key == "old-key" {
+			return newResponse(http.StatusUnauthorized, `{"error":"invalid api key"}`, nil), nil
+		}
+		return newResponse(http.StatusOK, `[]`, nil), nil
+	})
+
+	// Cache headers signed with the old key, then rotate the file.
+	_, err = client.GetTransactions(context.Background())
+	require.Error(t, err)
+	writeBuilderCredentials(t, path, "new-key")
+
+	_, err = client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-key", "old-key", "new-key"}, keys)
+}
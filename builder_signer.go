@@ -0,0 +1,193 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// BuilderSigner produces the builder attribution headers of a relayer request. A zero
+// timestamp means now; see BuilderConfig.Headers. *BuilderCredentials signs locally,
+// *BuilderRemoteConfig calls a remote signing service and *BuilderConfig combines them;
+// set BuilderConfig.Signer to use any other implementation.
+type BuilderSigner interface {
+	Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error)
+}
+
+var (
+	_ BuilderSigner = (*BuilderCredentials)(nil)
+	_ BuilderSigner = (*BuilderRemoteConfig)(nil)
+	_ BuilderSigner = (*BuilderConfig)(nil)
+)
+
+// FallbackBuilderSigner tries each signer in order and returns the first headers produced.
+type FallbackBuilderSigner []BuilderSigner
+
+// Headers implements BuilderSigner. It stops early when ctx is done; otherwise the
+// error joins the errors of every signer.
+func (f FallbackBuilderSigner) Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	return signWithFallback(ctx, f, 0, method, path, body, timestamp)
+}
+
+// Refresh implements RefreshableBuilderSigner by refreshing every signer that supports it.
+func (f FallbackBuilderSigner) Refresh(ctx context.Context) error {
+	return refreshBuilderSigners(ctx, f)
+}
+
+func refreshBuilderSigners(ctx context.Context, signers []BuilderSigner) error {
+	var errs []error
+	for _, signer := range signers {
+		if r, ok := signer.(RefreshableBuilderSigner); ok {
+			if err := r.Refresh(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func signWithFallback(ctx context.Context, signers []BuilderSigner, start int, method, path string, body *string, timestamp int64) (http.Header, error) {
+	if len(signers) == 0 {
+		return nil, types.ErrMissingBuilderConfig
+	}
+	var errs []error
+	for i := range signers {
+		signer := signers[(start+i)%len(signers)]
+		if signer == nil {
+			errs = append(errs, types.ErrMissingBuilderConfig)
+			continue
+		}
+		headers, err := signer.Headers(ctx, method, path, body, timestamp)
+		if err == nil {
+			return headers, nil
+		}
+		errs = append(errs, err)
+		if ctx != nil && ctx.Err() != nil {
+			break
+		}
+	}
+	if len(errs) == 1 {
+		return nil, errs[0]
+	}
+	return nil, fmt.Errorf("all %d builder signers failed: %w", len(errs), errors.Join(errs...))
+}
+
+// RoundRobinBuilderSigner spreads requests across signers, e.g. several remote signing
+// services. Each call starts at the next signer and fails over to the others in order.
+type RoundRobinBuilderSigner struct {
+	signers []BuilderSigner
+	next    atomic.Uint64
+}
+
+// NewRoundRobinBuilderSigner creates a RoundRobinBuilderSigner over signers.
+func NewRoundRobinBuilderSigner(signers ...BuilderSigner) *RoundRobinBuilderSigner {
+	return &RoundRobinBuilderSigner{signers: signers}
+}
+
+// Headers implements BuilderSigner.
+func (r *RoundRobinBuilderSigner) Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	if len(r.signers) == 0 {
+		return nil, types.ErrMissingBuilderConfig
+	}
+	start := int((r.next.Add(1) - 1) % uint64(len(r.signers)))
+	return signWithFallback(ctx, r.signers, start, method, path, body, timestamp)
+}
+
+// Refresh implements RefreshableBuilderSigner by refreshing every signer that supports it.
+func (r *RoundRobinBuilderSigner) Refresh(ctx context.Context) error {
+	return refreshBuilderSigners(ctx, r.signers)
+}
+
+const cachingBuilderSignerLimit = 256
+
+// CachingBuilderSigner reuses the headers of identical requests for a short time, which
+// saves remote signer round trips for repeated reads such as nonce and transaction polls.
+// Headers are reused for requested timestamps within the TTL of the one they were signed
+// for. The TTL must stay well inside the relayer's timestamp tolerance, since cached
+// headers keep the timestamp they were signed with.
+type CachingBuilderSigner struct {
+	signer BuilderSigner
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedBuilderHeaders
+}
+
+type cachedBuilderHeaders struct {
+	headers   http.Header
+	signedFor time.Time
+	expires   time.Time
+}
+
+// NewCachingBuilderSigner caches the headers produced by signer for ttl.
+func NewCachingBuilderSigner(signer BuilderSigner, ttl time.Duration) *CachingBuilderSigner {
+	return &CachingBuilderSigner{
+		signer:  signer,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cachedBuilderHeaders),
+	}
+}
+
+// Headers implements BuilderSigner. The returned headers are a copy the caller may modify.
+func (c *CachingBuilderSigner) Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	if c.signer == nil {
+		return nil, types.ErrMissingBuilderConfig
+	}
+	key := method + " " + path
+	if body != nil {
+		key += " " + *body
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	now := c.now()
+	requested := now
+	if timestamp != 0 {
+		requested = time.UnixMilli(normalizeBuilderTimestamp(timestamp))
+	}
+	if ok && now.Before(entry.expires) && requested.Sub(entry.signedFor).Abs() < c.ttl {
+		c.mu.Unlock()
+		return entry.headers.Clone(), nil
+	}
+	c.mu.Unlock()
+
+	headers, err := c.signer.Headers(ctx, method, path, body, timestamp)
+	if err != nil || c.ttl <= 0 {
+		return headers, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= cachingBuilderSignerLimit {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= cachingBuilderSignerLimit {
+			clear(c.entries)
+		}
+	}
+	c.entries[key] = cachedBuilderHeaders{headers: headers.Clone(), signedFor: requested, expires: now.Add(c.ttl)}
+	return headers, nil
+}
+
+// Refresh implements RefreshableBuilderSigner. It drops the cached headers, which may carry
+// a revoked key, and refreshes the wrapped signer if it supports it.
+func (c *CachingBuilderSigner) Refresh(ctx context.Context) error {
+	c.mu.Lock()
+	clear(c.entries)
+	c.mu.Unlock()
+	if r, ok := c.signer.(RefreshableBuilderSigner); ok {
+		return r.Refresh(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// stubBuilderSigner returns headers carrying its key, or err.
+type stubBuilderSigner struct {
+	key string
+	err error
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *stubBuilderSigner) Headers(ctx context.Context, method, path string, body *string, timestamp int64) (http.Header, error) {
+	s.mu.Lock()
+	s.calls++
+	s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	headers := http.Header{}
+	headers.Set(HeaderPolyBuilderAPIKey, s.key)
+	headers.Set(HeaderPolyBuilderTimestamp, "1730000000000")
+	return headers, nil
+}
+
+func (s *stubBuilderSigner) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func TestBuilderConfig_CustomSigner(t *testing.T) {
+	t.Parallel()
+
+	custom := &stubBuilderSigner{key: "vault-key"}
+	builderCfg := &BuilderConfig{Signer: custom, Local: testBuilderConfig().Local}
+	require.True(t, builderCfg.IsValid())
+
+	client, err := NewRelayClientWithOptions("https://example.test", 137,
+		WithBuilderConfig(builderCfg),
+		WithHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "vault-key", req.Header.Get(HeaderPolyBuilderAPIKey))
+			return newResponse(http.StatusOK, `[]`, nil), nil
+		})})),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, custom.callCount())
+}
+
+func TestBuilderCredentials_ImplementsBuilderSigner(t *testing.T) {
+	t.Parallel()
+
+	creds := testBuilderConfig().Local
+	body := `{"a":1}`
+	fromCreds, err := creds.Headers(context.Background(), http.MethodPost, "/submit", &body, 1730000000000)
+	require.NoError(t, err)
+	fromConfig, err := (&BuilderConfig{Local: creds}).Headers(context.Background(), http.MethodPost, "/submit", &body, 1730000000000)
+	require.NoError(t, err)
+	assert.Equal(t, fromConfig, fromCreds)
+}
+
+func TestFallbackBuilderSigner(t *testing.T) {
+	t.Parallel()
+
+	down := &stubBuilderSigner{err: errors.New("vault sealed")}
+	backup := &stubBuilderSigner{key: "backup-key"}
+	headers, err := FallbackBuilderSigner{down, nil, backup}.Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "backup-key", headers.Get(HeaderPolyBuilderAPIKey))
+	assert.Equal(t, 1, down.callCount())
+
+	other := &stubBuilderSigner{err: errors.New("remote down")}
+	_, err = FallbackBuilderSigner{down, other}.Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+	assert.ErrorContains(t, err, "vault sealed")
+	assert.ErrorContains(t, err, "remote down")
+
+	_, err = FallbackBuilderSigner{}.Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+	assert.ErrorIs(t, err, types.ErrMissingBuilderConfig)
+}
+
+func TestFallbackBuilderSigner_StopsWhenContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	first := &stubBuilderSigner{err: context.Canceled}
+	second := &stubBuilderSigner{key: "unused"}
+	_, err := FallbackBuilderSigner{first, second}.Headers(ctx, http.MethodGet, "/nonce", nil, 0)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, second.callCount())
+}
+
+func TestRoundRobinBuilderSigner(t *testing.T) {
+	t.Parallel()
+
+	a := &stubBuilderSigner{key: "a"}
+	b := &stubBuilderSigner{key: "b"}
+	c := &stubBuilderSigner{err: errors.New("c down")}
+	rr := NewRoundRobinBuilderSigner(a, b, c)
+
+	var keys []string
+	for range 6 {
+		headers, err := rr.Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+		require.NoError(t, err)
+		keys = append(keys, headers.Get(HeaderPolyBuilderAPIKey))
+	}
+	// The third signer fails and its turn falls over to the first.
+	assert.Equal(t, []string{"a", "b", "a", "a", "b", "a"}, keys)
+	assert.Equal(t, 2, c.callCount())
+
+	_, err := NewRoundRobinBuilderSigner().Headers(context.Background(), http.MethodGet, "/nonce", nil, 0)
+	assert.ErrorIs(t, err, types.ErrMissingBuilderConfig)
+}
+
+func TestCachingBuilderSigner(t *testing.T) {
+	t.Parallel()
+
+	inner := &stubBuilderSigner{key: "remote-key"}
+	cache := NewCachingBuilderSigner(inner, 5*time.Second)
+	now := time.Unix(1730000000, 0)
+	cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	first, err := cache.Headers(ctx, http.MethodGet, "/nonce", nil, 0)
+	require.NoError(t, err)
+	first.Set(HeaderPolyBuilderAPIKey, "mutated")
+
+	second, err := cache.Headers(ctx, http.MethodGet, "/nonce", nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "remote-key", second.Get(HeaderPolyBuilderAPIKey))
+	assert.Equal(t, 1, inner.callCount())
+
+	body := `{"a":1}`
+	_, err = cache.Headers(ctx, http.MethodPost, "/submit", &body, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, inner.callCount())
+
+	now = now.Add(5 * time.Second)
+	_, err = cache.Headers(ctx, http.MethodGet, "/nonce", nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, inner.callCount())
+
+	// Compensated timestamps within the TTL of the cached one reuse it.
+	body = `{"b":2}`
+	signedAt := now.UnixMilli()
+	_, err = cache.Headers(ctx, http.MethodPost, "/submit", &body, signedAt)
+	require.NoError(t, err)
+	_, err = cache.Headers(ctx, http.MethodPost, "/submit", &body, signedAt+1000)
+	require.NoError(t, err)
+	assert.Equal(t, 4, inner.callCount())
+	_, err = cache.Headers(ctx, http.MethodPost, "/submit", &body, signedAt+10_000)
+	require.NoError(t, err)
+	assert.Equal(t, 5, inner.callCount())
+
+	inner.err = errors.New("remote down")
+	now = now.Add(time.Minute)
+	_, err = cache.Headers(ctx, http.MethodGet, "/nonce", nil, 0)
+	assert.ErrorContains(t, err, "remote down")
+}
@@ -0,0 +1,267 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+)
+
+const (
+	defaultCircuitFailureRatio   = 0.5
+	defaultCircuitMinRequests    = uint(10)
+	defaultCircuitWindow         = 60 * time.Second
+	defaultCircuitCoolDown       = 30 * time.Second
+	defaultCircuitHalfOpenProbes = uint(1)
+)
+
+// CircuitState is the state of a per-host circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through and counts failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests until the cool-down elapses.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through.
+	CircuitHalfOpen
+)
+
+// String returns the string representation of the circuit state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "CLOSED"
+	case CircuitOpen:
+		return "OPEN"
+	case CircuitHalfOpen:
+		return "HALF_OPEN"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// CircuitBreakerConfig configures the circuit breaker installed by WithCircuitBreaker.
+// Zero values fall back to sensible defaults.
+type CircuitBreakerConfig struct {
+	// FailureRatio is the fraction of failed requests (0-1] that trips the breaker.
+	FailureRatio float64
+	// MinRequests is the number of requests that must be observed in a window before the ratio is evaluated.
+	MinRequests uint
+	// Window is the interval after which the closed-state counters are reset.
+	Window time.Duration
+	// CoolDown is how long the breaker stays open before probes are allowed.
+	CoolDown time.Duration
+	// HalfOpenProbes is the number of successful probes required to close the breaker again.
+	HalfOpenProbes uint
+	// OnStateChange, if set, is called after every state transition.
+	OnStateChange func(host string, from, to CircuitState)
+}
+
+func (c CircuitBreakerConfig) withDefaults() CircuitBreakerConfig {
+	if c.FailureRatio <= 0 || c.FailureRatio > 1 {
+		c.FailureRatio = defaultCircuitFailureRatio
+	}
+	if c.MinRequests == 0 {
+		c.MinRequests = defaultCircuitMinRequests
+	}
+	if c.Window <= 0 {
+		c.Window = defaultCircuitWindow
+	}
+	if c.CoolDown <= 0 {
+		c.CoolDown = defaultCircuitCoolDown
+	}
+	if c.HalfOpenProbes == 0 {
+		c.HalfOpenProbes = defaultCircuitHalfOpenProbes
+	}
+	return c
+}
+
+// WithCircuitBreaker enables a circuit breaker per relayer host.
+// While a breaker is open, Do fails fast with an error matching sdkerrors.ErrCircuitOpen;
+// requests beyond the probe budget in half-open state fail with sdkerrors.ErrTooManyRequests.
+func WithCircuitBreaker(config CircuitBreakerConfig) HTTPClientOption {
+	return func(c *HTTPClient) { c.breakers = newCircuitBreakers(config) }
+}
+
+type circuitResult int
+
+const (
+	circuitSuccess circuitResult = iota
+	circuitFailure
+	circuitIgnored
+)
+
+type circuitBreakers struct {
+	config CircuitBreakerConfig
+	now    func() time.Time
+
+	mu    sync.Mutex
+	hosts map[string]*circuitBreaker
+}
+
+func newCircuitBreakers(config CircuitBreakerConfig) *circuitBreakers {
+	return &circuitBreakers{
+		config: config.withDefaults(),
+		now:    time.Now,
+		hosts:  make(map[string]*circuitBreaker),
+	}
+}
+
+func (b *circuitBreakers) get(host string) *circuitBreaker {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	cb, ok := b.hosts[host]
+	if !ok {
+		cb = &circuitBreaker{host: host, parent: b, windowStart: b.now()}
+		b.hosts[host] = cb
+	}
+	return cb
+}
+
+func (b *circuitBreakers) state(host string) CircuitState {
+	cb := b.get(host)
+	cb.mu.Lock()
+	from, to := cb.advance(b.now())
+	state := cb.state
+	cb.mu.Unlock()
+	cb.notify(from, to)
+	return state
+}
+
+type circuitBreaker struct {
+	host   string
+	parent *circuitBreakers
+
+	mu             sync.Mutex
+	state          CircuitState
+	generation     uint64
+	requests       uint
+	failures       uint
+	windowStart    time.Time
+	openedAt       time.Time
+	probesInFlight uint
+	probeSuccesses uint
+}
+
+// allow reports whether a request may proceed and returns the generation it belongs to.
+func (cb *circuitBreaker) allow() (uint64, error) {
+	cfg := cb.parent.config
+	cb.mu.Lock()
+	from, to := cb.advance(cb.parent.now())
+
+	var err error
+	switch cb.state {
+	case CircuitOpen:
+		err = fmt.Errorf("%w: %s", sdkerrors.ErrCircuitOpen, cb.host)
+	case CircuitHalfOpen:
+		if cb.probesInFlight+cb.probeSuccesses >= cfg.HalfOpenProbes {
+			err = fmt.Errorf("%w: circuit half-open for %s", sdkerrors.ErrTooManyRequests, cb.host)
+		} else {
+			cb.probesInFlight++
+		}
+	default:
+		cb.requests++
+	}
+	generation := cb.generation
+	cb.mu.Unlock()
+
+	cb.notify(from, to)
+	return generation, err
+}
+
+// record reports the outcome of a request admitted by allow. It is a no-op on a nil breaker.
+func (cb *circuitBreaker) record(generation uint64, result circuitResult) {
+	if cb == nil {
+		return
+	}
+	cfg := cb.parent.config
+	cb.mu.Lock()
+	now := cb.parent.now()
+	from, to := cb.advance(now)
+	if generation != cb.generation {
+		// The breaker changed state since the request was admitted; its outcome is stale.
+		cb.mu.Unlock()
+		cb.notify(from, to)
+		return
+	}
+
+	switch cb.state {
+	case CircuitClosed:
+		switch result {
+		case circuitFailure:
+			cb.failures++
+			if cb.requests >= cfg.MinRequests && float64(cb.failures)/float64(cb.requests) >= cfg.FailureRatio {
+				from, to = cb.setState(CircuitOpen, now)
+			}
+		case circuitIgnored:
+			if cb.requests > 0 {
+				cb.requests--
+			}
+		}
+	case CircuitHalfOpen:
+		if cb.probesInFlight > 0 {
+			cb.probesInFlight--
+		}
+		switch result {
+		case circuitFailure:
+			from, to = cb.setState(CircuitOpen, now)
+		case circuitSuccess:
+			cb.probeSuccesses++
+			if cb.probeSuccesses >= cfg.HalfOpenProbes {
+				from, to = cb.setState(CircuitClosed, now)
+			}
+		}
+	}
+	cb.mu.Unlock()
+
+	cb.notify(from, to)
+}
+
+// advance applies time-based transitions. Callers must hold cb.mu.
+func (cb *circuitBreaker) advance(now time.Time) (CircuitState, CircuitState) {
+	cfg := cb.parent.config
+	switch cb.state {
+	case CircuitClosed:
+		if now.Sub(cb.windowStart) >= cfg.Window {
+			cb.requests, cb.failures = 0, 0
+			cb.windowStart = now
+		}
+	case CircuitOpen:
+		if now.Sub(cb.openedAt) >= cfg.CoolDown {
+			return cb.setState(CircuitHalfOpen, now)
+		}
+	}
+	return cb.state, cb.state
+}
+
+// setState moves the breaker to a new state and resets its counters. Callers must hold cb.mu.
+func (cb *circuitBreaker) setState(state CircuitState, now time.Time) (CircuitState, CircuitState) {
+	from := cb.state
+	cb.state = state
+	cb.generation++
+	cb.requests, cb.failures = 0, 0
+	cb.probesInFlight, cb.probeSuccesses = 0, 0
+	cb.windowStart = now
+	if state == CircuitOpen {
+		cb.openedAt = now
+	}
+	return from, state
+}
+
+// networkCircuitResult classifies a transport error; cancellations by the caller are not the host's fault.
+func networkCircuitResult(ctx context.Context) circuitResult {
+	if ctx.Err() != nil {
+		return circuitIgnored
+	}
+	return circuitFailure
+}
+
+func (cb *circuitBreaker) notify(from, to CircuitState) {
+	if from == to || cb.parent.config.OnStateChange == nil {
+		return
+	}
+	cb.parent.config.OnStateChange(cb.host, from, to)
+}
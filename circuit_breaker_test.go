@@ -0,0 +1,114 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+)
+
+type circuitTransition struct {
+	host     string
+	from, to CircuitState
+}
+
+func TestHTTPClientDo_CircuitBreakerOpensAndFailsFast(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var transitions []circuitTransition
+	attempts := 0
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newResponse(http.StatusBadGateway, `{"error":"bad gateway"}`, nil), nil
+	})}
+
+	client := NewHTTPClient(base, WithMaxRetries(0), WithCircuitBreaker(CircuitBreakerConfig{
+		FailureRatio: 0.5,
+		MinRequests:  2,
+		CoolDown:     time.Hour,
+		OnStateChange: func(host string, from, to CircuitState) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, circuitTransition{host, from, to})
+		},
+	}))
+
+	for i := 0; i < 2; i++ {
+		err := client.Do(context.Background(), http.MethodGet, "https://relayer.test/nonce", nil, nil)
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, sdkerrors.ErrCircuitOpen))
+	}
+
+	err := client.Do(context.Background(), http.MethodGet, "https://relayer.test/nonce", nil, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrCircuitOpen)
+	assert.Equal(t, 2, attempts, "open circuit must not reach the transport")
+	assert.Equal(t, CircuitOpen, client.CircuitState("relayer.test"))
+	assert.Equal(t, CircuitClosed, client.CircuitState("other.test"))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, transitions, 1)
+	assert.Equal(t, circuitTransition{"relayer.test", CircuitClosed, CircuitOpen}, transitions[0])
+}
+
+func TestHTTPClientDo_CircuitBreakerHalfOpenProbeCloses(t *testing.T) {
+	t.Parallel()
+
+	healthy := false
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if healthy {
+			return newResponse(http.StatusOK, `{}`, nil), nil
+		}
+		return newResponse(http.StatusServiceUnavailable, `{}`, nil), nil
+	})}
+
+	client := NewHTTPClient(base, WithMaxRetries(0), WithCircuitBreaker(CircuitBreakerConfig{
+		FailureRatio: 1,
+		MinRequests:  1,
+		CoolDown:     time.Minute,
+	}))
+	now := time.Now()
+	client.breakers.now = func() time.Time { return now }
+
+	require.Error(t, client.Do(context.Background(), http.MethodGet, "https://relayer.test/nonce", nil, nil))
+	assert.Equal(t, CircuitOpen, client.CircuitState("relayer.test"))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, CircuitHalfOpen, client.CircuitState("relayer.test"))
+
+	healthy = true
+	require.NoError(t, client.Do(context.Background(), http.MethodGet, "https://relayer.test/nonce", nil, nil))
+	assert.Equal(t, CircuitClosed, client.CircuitState("relayer.test"))
+}
+
+func TestCircuitBreaker_HalfOpenRejectsBeyondProbeBudget(t *testing.T) {
+	t.Parallel()
+
+	breakers := newCircuitBreakers(CircuitBreakerConfig{FailureRatio: 1, MinRequests: 1, CoolDown: time.Minute, HalfOpenProbes: 1})
+	now := time.Now()
+	breakers.now = func() time.Time { return now }
+	cb := breakers.get("relayer.test")
+
+	gen, err := cb.allow()
+	require.NoError(t, err)
+	cb.record(gen, circuitFailure)
+
+	now = now.Add(time.Minute)
+	probeGen, err := cb.allow()
+	require.NoError(t, err)
+
+	_, err = cb.allow()
+	require.ErrorIs(t, err, sdkerrors.ErrTooManyRequests)
+
+	cb.record(probeGen, circuitFailure)
+	_, err = cb.allow()
+	require.ErrorIs(t, err, sdkerrors.ErrCircuitOpen)
+}
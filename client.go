@@ -3,14 +3,16 @@ package relayer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/GoPolymarket/go-builder-relayer-client/internal/builder"
-	"github.com/GoPolymarket/go-builder-relayer-client/internal/encoder"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/logger"
 	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
 	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
 )
@@ -25,28 +27,34 @@ type RelayClient struct {
 	signer         signer.Signer
 	builderConfig  *BuilderConfig
 	sleepFn        func(context.Context, time.Duration) error
+	logger         logger.Logger
+	waitOptions    WaitOptions
+	skew           clockSkew
+	skewDisabled   bool
+
+	delegateCallPolicy DelegateCallPolicy
+	nonces             *NonceManager
+	journal            Journal
+	remoteSignerClient BuilderHTTPDoer
+	idempotency        *idempotencyRecord
+	retryMu            sync.RWMutex
+	retryPolicies      map[string]RetryPolicy
 }
 
 func NewRelayClient(relayerURL string, chainID int64, signer signer.Signer, builderConfig *BuilderConfig, relayTxType types.RelayerTxType) (*RelayClient, error) {
-	cleanURL := strings.TrimRight(relayerURL, "/")
-	if relayTxType == "" {
-		relayTxType = types.RelayerTxSafe
-	}
-	config, err := GetContractConfig(chainID)
-	if err != nil {
-		return nil, err
-	}
+	return NewRelayClientWithRegistry(relayerURL, chainID, signer, builderConfig, relayTxType, DefaultContractRegistry)
+}
 
-	return &RelayClient{
-		relayerURL:     cleanURL,
-		chainID:        chainID,
-		relayTxType:    relayTxType,
-		contractConfig: config,
-		httpClient:     NewHTTPClient(nil),
-		signer:         signer,
-		builderConfig:  builderConfig,
-		sleepFn:        sleepWithContext,
-	}, nil
+// NewRelayClientWithRegistry is like NewRelayClient but resolves the chain's contracts from
+// registry, e.g. for forks, local devnets or new deployments. A nil registry means
+// DefaultContractRegistry.
+func NewRelayClientWithRegistry(relayerURL string, chainID int64, signer signer.Signer, builderConfig *BuilderConfig, relayTxType types.RelayerTxType, registry *ContractRegistry) (*RelayClient, error) {
+	return NewRelayClientWithOptions(relayerURL, chainID,
+		WithSigner(signer),
+		WithBuilderConfig(builderConfig),
+		WithRelayerTxType(relayTxType),
+		WithContractRegistry(registry),
+	)
 }
 
 // SetHTTPClient allows overriding the underlying HTTP client.
@@ -87,41 +95,13 @@ func (c *RelayClient) GetDeployed(ctx context.Context, safeAddress string) (bool
 }
 
 // Execute executes a batch of transactions.
-func (c *RelayClient) Execute(ctx context.Context, txns []types.Transaction, metadata string) (*ClientRelayerTransactionResponse, error) {
-	if c.signer == nil {
-		return nil, types.ErrSignerUnavailable
-	}
-	if len(txns) == 0 {
-		return nil, types.ErrNoTransactions
-	}
-
-	switch c.relayTxType {
-	case types.RelayerTxSafe:
-		safeTxns := make([]types.SafeTransaction, 0, len(txns))
-		for _, tx := range txns {
-			value := tx.Value
-			if value == "" {
-				value = "0"
-			}
-			safeTxns = append(safeTxns, types.SafeTransaction{To: tx.To, Operation: types.OperationCall, Data: tx.Data, Value: value})
-		}
-		return c.executeSafeTransactions(ctx, safeTxns, metadata)
-	case types.RelayerTxProxy:
-		proxyTxns := make([]types.ProxyTransaction, 0, len(txns))
-		for _, tx := range txns {
-			value := tx.Value
-			if value == "" {
-				value = "0"
-			}
-			proxyTxns = append(proxyTxns, types.ProxyTransaction{To: tx.To, TypeCode: types.CallTypeCall, Data: tx.Data, Value: value})
-		}
-		return c.executeProxyTransactions(ctx, proxyTxns, metadata)
-	default:
-		return nil, fmt.Errorf("%w: %s", types.ErrUnsupportedTxType, c.relayTxType)
-	}
+func (c *RelayClient) Execute(ctx context.Context, txns []types.Transaction, metadata string, opts ...ExecuteOption) (*ClientRelayerTransactionResponse, error) {
+	return c.executeIdempotent(ctx, metadata, opts, func(ctx context.Context, metadata string) (*PreparedTransaction, error) {
+		return c.prepare(ctx, txns, metadata, true)
+	})
 }
 
-func (c *RelayClient) executeProxyTransactions(ctx context.Context, txns []types.ProxyTransaction, metadata string) (*ClientRelayerTransactionResponse, error) {
+func (c *RelayClient) prepareProxyTransactions(ctx context.Context, txns []types.ProxyTransaction, metadata string) (*PreparedTransaction, error) {
 	if !IsProxyContractConfigValid(c.contractConfig.ProxyContracts) {
 		return nil, types.ErrConfigUnsupported
 	}
@@ -130,42 +110,12 @@ func (c *RelayClient) executeProxyTransactions(ctx context.Context, txns []types
 	if err != nil {
 		return nil, err
 	}
-	data, err := encoder.EncodeProxyTransactionData(txns)
-	if err != nil {
-		return nil, err
-	}
-
-	args := types.ProxyTransactionArgs{
-		From:     from,
-		GasPrice: "0",
-		Data:     data,
-		Relay:    relayPayload.Address,
-		Nonce:    relayPayload.Nonce,
-	}
-
-	request, err := builder.BuildProxyTransactionRequest(ctx, c.signer, args, c.contractConfig.ProxyContracts, metadata)
-	if err != nil {
-		return nil, err
-	}
-
-	payload, err := json.Marshal(request)
-	if err != nil {
-		return nil, fmt.Errorf("encode request: %w", err)
-	}
-
-	var resp types.RelayerTransactionResponse
-	if err := c.sendAuthedRequest(ctx, "POST", SubmitTransactionEndpoint, string(payload), &resp); err != nil {
-		return nil, err
-	}
-	return &ClientRelayerTransactionResponse{
-		TransactionID:   resp.TransactionID,
-		State:           resp.State,
-		TransactionHash: resp.TransactionHash,
-		client:          c,
-	}, nil
+	return signProxyTransactions(ctx, c.signer, c.contractConfig.ProxyContracts, txns, relayPayload, "", metadata)
 }
 
-func (c *RelayClient) executeSafeTransactions(ctx context.Context, txns []types.SafeTransaction, metadata string) (*ClientRelayerTransactionResponse, error) {
+// prepareSafeTransactions signs Safe transactions. With a NonceManager set, reserve takes the
+// nonce from it; otherwise the next managed nonce is only peeked at.
+func (c *RelayClient) prepareSafeTransactions(ctx context.Context, txns []types.SafeTransaction, metadata string, reserve bool) (*PreparedTransaction, error) {
 	if !IsSafeContractConfigValid(c.contractConfig.SafeContracts) {
 		return nil, types.ErrConfigUnsupported
 	}
@@ -182,29 +132,44 @@ func (c *RelayClient) executeSafeTransactions(ctx context.Context, txns []types.
 	}
 
 	from := c.signer.Address().Hex()
-	noncePayload, err := c.GetNonce(ctx, from, string(types.TransactionTypeSafe))
-	if err != nil {
-		return nil, err
-	}
-	if noncePayload.Nonce == "" {
-		return nil, types.ErrInvalidNoncePayload
+	if c.nonces == nil {
+		noncePayload, err := c.GetNonce(ctx, from, string(types.TransactionTypeSafe))
+		if err != nil {
+			return nil, err
+		}
+		if noncePayload.Nonce == "" {
+			return nil, types.ErrInvalidNoncePayload
+		}
+		return signSafeTransactions(c.signer, c.chainID, c.contractConfig.SafeContracts, txns, noncePayload.Nonce, metadata)
 	}
 
-	args := types.SafeTransactionArgs{
-		From:         from,
-		Nonce:        noncePayload.Nonce,
-		ChainID:      c.chainID,
-		Transactions: txns,
+	key := nonceKey(c.relayerURL, c.chainID, from, types.TransactionTypeSafe)
+	fetch := func(ctx context.Context) (string, error) {
+		noncePayload, err := c.GetNonce(ctx, from, string(types.TransactionTypeSafe))
+		return noncePayload.Nonce, err
+	}
+	if !reserve {
+		nonce, err := c.nonces.Peek(ctx, key, fetch)
+		if err != nil {
+			return nil, err
+		}
+		return signSafeTransactions(c.signer, c.chainID, c.contractConfig.SafeContracts, txns, nonce, metadata)
 	}
-	request, err := builder.BuildSafeTransactionRequest(c.signer, args, c.contractConfig.SafeContracts, metadata)
+	nonce, err := c.nonces.Reserve(ctx, key, fetch)
 	if err != nil {
 		return nil, err
 	}
-	payload, err := json.Marshal(request)
+	prepared, err := signSafeTransactions(c.signer, c.chainID, c.contractConfig.SafeContracts, txns, nonce, metadata)
 	if err != nil {
-		return nil, fmt.Errorf("encode request: %w", err)
+		c.nonces.Invalidate(key)
+		return nil, err
 	}
+	prepared.nonceKey = key
+	return prepared, nil
+}
 
+// submit posts an encoded transaction request to the relayer.
+func (c *RelayClient) submit(ctx context.Context, payload []byte) (*ClientRelayerTransactionResponse, error) {
 	var resp types.RelayerTransactionResponse
 	if err := c.sendAuthedRequest(ctx, "POST", SubmitTransactionEndpoint, string(payload), &resp); err != nil {
 		return nil, err
@@ -256,17 +221,7 @@ func (c *RelayClient) deploySafe(ctx context.Context) (*ClientRelayerTransaction
 	if err != nil {
 		return nil, fmt.Errorf("encode request: %w", err)
 	}
-
-	var resp types.RelayerTransactionResponse
-	if err := c.sendAuthedRequest(ctx, "POST", SubmitTransactionEndpoint, string(payload), &resp); err != nil {
-		return nil, err
-	}
-	return &ClientRelayerTransactionResponse{
-		TransactionID:   resp.TransactionID,
-		State:           resp.State,
-		TransactionHash: resp.TransactionHash,
-		client:          c,
-	}, nil
+	return c.submitPrepared(ctx, &PreparedTransaction{Request: request, Body: payload})
 }
 
 func sleepWithContext(ctx context.Context, d time.Duration) error {
@@ -284,52 +239,19 @@ func sleepWithContext(ctx context.Context, d time.Duration) error {
 	}
 }
 
+// PollUntilState polls every pollFrequency (at least 1s) up to maxPolls times.
+// Use PollUntilStateWithOptions for adaptive schedules or a deadline.
 func (c *RelayClient) PollUntilState(ctx context.Context, transactionID string, states []types.RelayerTransactionState, failState types.RelayerTransactionState, maxPolls int, pollFrequency time.Duration) (*types.RelayerTransaction, error) {
-	stateSet := make(map[types.RelayerTransactionState]struct{}, len(states))
-	for _, s := range states {
-		stateSet[s] = struct{}{}
-	}
-
 	if maxPolls <= 0 {
 		maxPolls = 10
 	}
 	if pollFrequency < time.Second {
 		pollFrequency = 2 * time.Second
 	}
-
-	for i := 0; i < maxPolls; i++ {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		txns, err := c.GetTransaction(ctx, transactionID)
-		if err != nil {
-			return nil, err
-		}
-		if len(txns) > 0 {
-			txn := txns[0]
-			if _, ok := stateSet[txn.State]; ok {
-				return &txn, nil
-			}
-			if failState != "" && txn.State == failState {
-				return nil, fmt.Errorf("%w: %s", types.ErrTransactionFailed, txn.TransactionHash)
-			}
-		}
-
-		if i == maxPolls-1 {
-			continue
-		}
-		sleepFn := c.sleepFn
-		if sleepFn == nil {
-			sleepFn = sleepWithContext
-		}
-		if err := sleepFn(ctx, pollFrequency); err != nil {
-			return nil, err
-		}
-	}
-	return nil, types.ErrTransactionTimeout
+	return c.PollUntilStateWithOptions(ctx, transactionID, states, failState, PollOptions{
+		Strategy: FixedPoll{Interval: pollFrequency},
+		MaxPolls: maxPolls,
+	})
 }
 
 func (c *RelayClient) send(ctx context.Context, path string, method string, options *RequestOptions, out interface{}) error {
@@ -348,33 +270,69 @@ func (c *RelayClient) send(ctx context.Context, path string, method string, opti
 		}
 	}
 
-	headers := options.Headers
-	if headers == nil {
-		headers = http.Header{}
-	}
-	if c.builderConfig != nil && c.builderConfig.IsValid() {
-		signBody := ""
-		if len(options.Body) > 0 {
-			signBody = string(options.Body)
-		}
-		headersToAdd, err := c.builderConfig.Headers(ctx, method, signedPath, &signBody, 0)
-		if err != nil {
-			return err
-		}
-		for k, vals := range headersToAdd {
-			for _, v := range vals {
-				headers.Add(k, v)
-			}
-		}
-	} else {
+	if c.builderConfig == nil || !c.builderConfig.IsValid() {
 		return types.ErrMissingBuilderConfig
 	}
+	signBody := ""
+	if len(options.Body) > 0 {
+		signBody = string(options.Body)
+	}
+	signCtx := contextWithRemoteSignerClient(ctx, c.remoteSignerClient)
+	skew := c.skew.get()
+	builderHeaders, err := c.builderConfig.Headers(signCtx, method, signedPath, &signBody, c.builderTimestamp(skew))
+	if err != nil {
+		return err
+	}
 
-	options.Headers = headers
+	baseHeaders := options.Headers
+	options.Headers = withBuilderHeaders(baseHeaders, builderHeaders)
+	options.Interceptors = append(slices.Clip(options.Interceptors), c.skew.interceptor())
+	options.Endpoint = path
+	if options.Retry == nil {
+		options.Retry = c.retryPolicy(ctx, path)
+	}
 	url := c.relayerURL + path
+	err = c.httpClient.Do(ctx, method, url, options, out)
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnauthorized {
+		return err
+	}
+	// The builder key may have been rotated, or the response revealed that the local clock
+	// drifted: retry once if either changes the headers.
+	if refresher, ok := c.builderConfig.Signer.(RefreshableBuilderSigner); ok {
+		if refreshErr := refresher.Refresh(signCtx); refreshErr != nil {
+			c.log().Warn("refresh builder credentials after 401: %v", refreshErr)
+		}
+	}
+	newSkew := c.skew.get()
+	resigned, signErr := c.builderConfig.Headers(signCtx, method, signedPath, &signBody, c.builderTimestamp(newSkew))
+	if signErr != nil {
+		return err
+	}
+	keyRotated := resigned.Get(HeaderPolyBuilderAPIKey) != builderHeaders.Get(HeaderPolyBuilderAPIKey)
+	clockCorrected := !c.skewDisabled && (newSkew-skew).Abs() >= clockSkewThreshold
+	if !keyRotated && !clockCorrected {
+		return err
+	}
+	options.Headers = withBuilderHeaders(baseHeaders, resigned)
 	return c.httpClient.Do(ctx, method, url, options, out)
 }
 
+// withBuilderHeaders returns a copy of headers with the builder headers added.
+func withBuilderHeaders(headers, builderHeaders http.Header) http.Header {
+	merged := headers.Clone()
+	if merged == nil {
+		merged = http.Header{}
+	}
+	for k, vals := range builderHeaders {
+		for _, v := range vals {
+			merged.Add(k, v)
+		}
+	}
+	return merged
+}
+
 func (c *RelayClient) sendAuthedRequest(ctx context.Context, method, path string, body string, out interface{}) error {
 	opts := &RequestOptions{}
 	if body != "" {
@@ -387,5 +345,5 @@ func (c *RelayClient) getExpectedSafe() (string, error) {
 	if c.signer == nil {
 		return "", types.ErrSignerUnavailable
 	}
-	return builder.DeriveSafeAddress(c.signer.Address().Hex(), c.contractConfig.SafeContracts.SafeFactory)
+	return builder.DeriveSafeAddressWithConfig(c.signer.Address().Hex(), c.contractConfig.SafeContracts)
 }
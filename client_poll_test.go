@@ -76,3 +76,39 @@ func TestPollUntilState_CancelDuringSleepReturnsPromptly(t *testing.T) {
 	require.ErrorIs(t, err, context.DeadlineExceeded)
 	assert.Less(t, elapsed, 400*time.Millisecond, "cancelled context should interrupt sleep")
 }
+
+func TestWait_InvalidStateReturnsTransactionFailedError(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `[{"transactionID":"tx-id","transactionHash":"0xabc","state":"STATE_INVALID","metadata":"redeem"}]`, nil), nil
+	})}))
+
+	resp := &ClientRelayerTransactionResponse{TransactionID: "tx-id", client: client}
+	_, err := resp.Wait(context.Background())
+	require.ErrorIs(t, err, types.ErrTransactionFailed)
+
+	var failed *types.TransactionFailedError
+	require.ErrorAs(t, err, &failed)
+	assert.Equal(t, types.StateInvalid, failed.Transaction.State)
+	assert.Equal(t, "0xabc", failed.Transaction.TransactionHash)
+	assert.Equal(t, "redeem", failed.Transaction.Metadata)
+}
+
+func TestWaitUntil_ExecutedThreshold(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `[{"transactionID":"tx-id","state":"STATE_EXECUTED"}]`, nil), nil
+	})}))
+
+	resp := &ClientRelayerTransactionResponse{TransactionID: "tx-id", client: client}
+	txn, err := resp.WaitUntil(context.Background(), types.StateExecuted)
+	require.NoError(t, err)
+	assert.Equal(t, types.StateExecuted, txn.State)
+
+	assert.Equal(t, []types.RelayerTransactionState{types.StateMined, types.StateConfirmed}, statesReaching(""))
+	assert.Equal(t, []types.RelayerTransactionState{types.StateConfirmed}, statesReaching(types.StateConfirmed))
+}
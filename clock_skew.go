@@ -0,0 +1,91 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// clockSkewSamples bounds how many recent Date headers the skew estimate is the median of.
+	clockSkewSamples = 8
+	// clockSkewThreshold is the smallest skew compensated; the Date header only has second precision.
+	clockSkewThreshold = time.Second
+)
+
+// clockSkew estimates how far the relayer's clock is ahead of the local clock from the
+// Date header of its responses. The zero value is ready to use.
+type clockSkew struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	offset  time.Duration
+	now     func() time.Time
+}
+
+// observe records the Date header of a response received latency after it was sent. The
+// header has second precision, so each sample is taken at the middle of its second and
+// compared with the local time halfway through the round trip.
+func (s *clockSkew) observe(header http.Header, latency time.Duration) {
+	serverTime, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+	sample := serverTime.Add(500 * time.Millisecond).Sub(now().Add(-latency / 2))
+
+	if len(s.samples) < clockSkewSamples {
+		s.samples = append(s.samples, sample)
+	} else {
+		s.samples[s.next] = sample
+	}
+	s.next = (s.next + 1) % clockSkewSamples
+
+	sorted := slices.Sorted(slices.Values(s.samples))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		s.offset = (sorted[mid-1] + sorted[mid]) / 2
+	} else {
+		s.offset = sorted[mid]
+	}
+}
+
+func (s *clockSkew) get() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.offset
+}
+
+// interceptor returns the per-request interceptor that feeds responses to observe.
+func (s *clockSkew) interceptor() Interceptor {
+	return Interceptor{
+		AfterReceive: func(_ context.Context, _ RequestInfo, resp ResponseInfo) {
+			if resp.StatusCode != 0 {
+				s.observe(resp.Header, resp.Latency)
+			}
+		},
+	}
+}
+
+// ClockSkew returns the estimated offset of the relayer's clock from the local clock,
+// positive when the relayer is ahead. It is measured from the Date header of relayer
+// responses and is zero until the first response.
+func (c *RelayClient) ClockSkew() time.Duration {
+	return c.skew.get()
+}
+
+// builderTimestamp returns the timestamp to sign builder headers with: now on the relayer's
+// clock, or zero (meaning now on the local clock) when the skew is below a second.
+func (c *RelayClient) builderTimestamp(skew time.Duration) int64 {
+	if c.skewDisabled || skew.Abs() < clockSkewThreshold {
+		return 0
+	}
+	return time.Now().Add(skew).UnixMilli()
+}
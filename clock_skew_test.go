@@ -0,0 +1,124 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSkewTestClient serves every request with a Date header offset from the local clock.
+func newSkewTestClient(t *testing.T, offset time.Duration, opts ...RelayClientOption) (*RelayClient, *[]int64) {
+	t.Helper()
+	var timestamps []int64
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		ts, err := strconv.ParseInt(req.Header.Get(HeaderPolyBuilderTimestamp), 10, 64)
+		require.NoError(t, err)
+		timestamps = append(timestamps, ts)
+		return newResponse(http.StatusOK, `[]`, map[string]string{"Date": time.Now().Add(offset).UTC().Format(http.TimeFormat)}), nil
+	})
+	opts = append([]RelayClientOption{
+		WithBuilderConfig(testBuilderConfig()),
+		WithHTTPClient(NewHTTPClient(&http.Client{Transport: transport})),
+	}, opts...)
+	client, err := NewRelayClientWithOptions("https://example.test", 137, opts...)
+	require.NoError(t, err)
+	return client, &timestamps
+}
+
+func TestClockSkew_CompensatesBuilderTimestamp(t *testing.T) {
+	t.Parallel()
+
+	client, timestamps := newSkewTestClient(t, 90*time.Second)
+	assert.Zero(t, client.ClockSkew())
+
+	_, err := client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.InDelta(t, 90*time.Second, client.ClockSkew(), float64(time.Second))
+
+	_, err = client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	serverNow := time.Now().Add(90 * time.Second).UnixMilli()
+	assert.InDelta(t, serverNow, (*timestamps)[1], 2000)
+	assert.InDelta(t, time.Now().UnixMilli(), (*timestamps)[0], 2000)
+}
+
+func TestClockSkew_CompensationDisabled(t *testing.T) {
+	t.Parallel()
+
+	client, timestamps := newSkewTestClient(t, -time.Minute, WithClockSkewCompensation(false))
+	for range 2 {
+		_, err := client.GetTransactions(context.Background())
+		require.NoError(t, err)
+	}
+	// The skew is still measured but not applied.
+	assert.InDelta(t, -time.Minute, client.ClockSkew(), float64(time.Second))
+	assert.InDelta(t, time.Now().UnixMilli(), (*timestamps)[1], 2000)
+}
+
+func TestClockSkew_RetriesStaleSignatureOn401(t *testing.T) {
+	t.Parallel()
+
+	const offset = 5 * time.Minute
+	var attempts atomic.Int32
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		header := map[string]string{"Date": time.Now().Add(offset).UTC().Format(http.TimeFormat)}
+		ts, _ := strconv.ParseInt(req.Header.Get(HeaderPolyBuilderTimestamp), 10, 64)
+		if time.Since(time.UnixMilli(ts).Add(-offset)).Abs() > 30*time.Second {
+			return newResponse(http.StatusUnauthorized, `{"error":"stale timestamp"}`, header), nil
+		}
+		return newResponse(http.StatusOK, `[]`, header), nil
+	})
+	client, err := NewRelayClientWithOptions("https://example.test", 137,
+		WithBuilderConfig(testBuilderConfig()),
+		WithHTTPClient(NewHTTPClient(&http.Client{Transport: transport})),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetTransactions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestClockSkew_MedianIgnoresOutliers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := &clockSkew{now: func() time.Time { return now }}
+	for _, offset := range []time.Duration{10 * time.Second, 10 * time.Second, time.Hour, 10 * time.Second, -time.Hour} {
+		s.observe(http.Header{"Date": {now.Add(offset).Format(http.TimeFormat)}}, 0)
+	}
+	assert.Equal(t, 10*time.Second+500*time.Millisecond, s.get())
+
+	s.observe(http.Header{"Date": {"not a date"}}, 0)
+	assert.Equal(t, 10*time.Second+500*time.Millisecond, s.get())
+}
+
+func TestHTTPClient_PerRequestInterceptors(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	client := NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "call", req.Header.Get("X-Scope"))
+		return newResponse(http.StatusOK, `{}`, nil), nil
+	})}, WithInterceptors(Interceptor{
+		AfterReceive: func(context.Context, RequestInfo, ResponseInfo) { order = append(order, "client") },
+	}))
+
+	opts := &RequestOptions{Interceptors: []Interceptor{{
+		BeforeSend: func(_ context.Context, _ RequestInfo, extra http.Header) error {
+			extra.Set("X-Scope", "call")
+			return nil
+		},
+		AfterReceive: func(context.Context, RequestInfo, ResponseInfo) { order = append(order, "call") },
+	}}}
+	require.NoError(t, client.Do(context.Background(), http.MethodGet, "https://example.test/nonce", opts, nil))
+	assert.Equal(t, []string{"client", "call"}, order)
+	assert.Len(t, client.interceptors, 1)
+}
@@ -34,13 +34,7 @@ func IsSafeContractConfigValid(config types.SafeContractConfig) bool {
 	return config.SafeFactory != "" && config.SafeMultisend != ""
 }
 
+// GetContractConfig returns the contract config for chainID from DefaultContractRegistry.
 func GetContractConfig(chainID int64) (types.ContractConfig, error) {
-	switch chainID {
-	case 137:
-		return polygonConfig, nil
-	case 80002:
-		return amoyConfig, nil
-	default:
-		return types.ContractConfig{}, types.ErrConfigUnsupported
-	}
-}
\ No newline at end of file
+	return DefaultContractRegistry.Get(chainID)
+}
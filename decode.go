@@ -0,0 +1,85 @@
+package relayer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/encoder"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// DecodedTransaction lists the inner calls performed by a relayed transaction.
+type DecodedTransaction struct {
+	Type types.TransactionType
+	// SafeCalls is set for SAFE transactions. A MultiSend call is expanded into its inner calls.
+	SafeCalls []types.SafeTransaction
+	// ProxyCalls is set for PROXY transactions.
+	ProxyCalls []types.ProxyTransaction
+}
+
+// DecodeSafeMultisendData recovers the inner calls of MultiSend calldata produced for a Safe batch.
+func DecodeSafeMultisendData(data string) ([]types.SafeTransaction, error) {
+	return encoder.DecodeSafeMultisendData(data)
+}
+
+// DecodeProxyTransactionData recovers the inner calls of proxy factory calldata.
+func DecodeProxyTransactionData(data string) ([]types.ProxyTransaction, error) {
+	return encoder.DecodeProxyTransactionData(data)
+}
+
+// DecodeTransactionRequest recovers the calls carried by a signed transaction request.
+func DecodeTransactionRequest(req *types.TransactionRequest) (*DecodedTransaction, error) {
+	if req == nil {
+		return nil, types.ErrNoTransactions
+	}
+	operation := types.OperationCall
+	if req.SignatureParams.Operation != "" {
+		op, err := strconv.ParseUint(req.SignatureParams.Operation, 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", types.ErrInvalidOperation, req.SignatureParams.Operation)
+		}
+		operation = types.OperationType(op)
+	}
+	return decodeTransaction(types.TransactionType(req.Type), req.To, req.Data, "0", operation)
+}
+
+// DecodeRelayerTransaction recovers the calls of a transaction returned by GetTransaction or GetTransactions.
+func DecodeRelayerTransaction(txn types.RelayerTransaction) (*DecodedTransaction, error) {
+	operation := types.OperationCall
+	if encoder.IsSafeMultisendData(txn.Data) {
+		// The relayer does not report the Safe operation; multiSend is always delegate-called.
+		operation = types.OperationDelegateCall
+	}
+	return decodeTransaction(types.TransactionType(txn.Type), txn.To, txn.Data, txn.Value, operation)
+}
+
+func decodeTransaction(txType types.TransactionType, to, data, value string, operation types.OperationType) (*DecodedTransaction, error) {
+	if strings.TrimSpace(value) == "" {
+		value = "0"
+	}
+	decoded := &DecodedTransaction{Type: txType}
+	switch txType {
+	case types.TransactionTypeSafe:
+		if operation == types.OperationDelegateCall && encoder.IsSafeMultisendData(data) {
+			calls, err := encoder.DecodeSafeMultisendData(data)
+			if err != nil {
+				return nil, err
+			}
+			decoded.SafeCalls = calls
+			return decoded, nil
+		}
+		decoded.SafeCalls = []types.SafeTransaction{{To: to, Operation: operation, Data: data, Value: value}}
+	case types.TransactionTypeProxy:
+		calls, err := encoder.DecodeProxyTransactionData(data)
+		if err != nil {
+			return nil, err
+		}
+		decoded.ProxyCalls = calls
+	case types.TransactionTypeSafeCreate:
+		// Safe deployments carry no inner calls.
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrUnsupportedTxType, txType)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,68 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+var decodeTestTxns = []types.Transaction{
+	{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x095ea7b3", Value: "0"},
+	{To: "0x4D97DCd97eC945f40cF65F87097ACe5EA0476045", Data: "0x", Value: "5"},
+}
+
+func TestDecodeTransactionRequest_SafeMultisendRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSigner()
+	prepared, err := SignTransactionRequest(context.Background(), s, decodeTestTxns, OfflineSignParams{ChainID: 137, Nonce: "1"})
+	require.NoError(t, err)
+
+	decoded, err := DecodeTransactionRequest(prepared.Request)
+	require.NoError(t, err)
+	assert.Equal(t, types.TransactionTypeSafe, decoded.Type)
+	assert.Equal(t, toSafeTransactions(decodeTestTxns), decoded.SafeCalls)
+
+	// The same calldata as reported back by the relayer.
+	decoded, err = DecodeRelayerTransaction(types.RelayerTransaction{Type: "SAFE", To: prepared.Request.To, Data: prepared.Request.Data})
+	require.NoError(t, err)
+	assert.Equal(t, toSafeTransactions(decodeTestTxns), decoded.SafeCalls)
+}
+
+func TestDecodeTransactionRequest_SafeSingleCall(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSigner()
+	prepared, err := SignTransactionRequest(context.Background(), s, decodeTestTxns[:1], OfflineSignParams{ChainID: 137, Nonce: "1"})
+	require.NoError(t, err)
+
+	decoded, err := DecodeTransactionRequest(prepared.Request)
+	require.NoError(t, err)
+	assert.Equal(t, toSafeTransactions(decodeTestTxns[:1]), decoded.SafeCalls)
+}
+
+func TestDecodeTransactionRequest_ProxyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSigner()
+	prepared, err := SignTransactionRequest(context.Background(), s, decodeTestTxns, OfflineSignParams{
+		ChainID:  137,
+		TxType:   types.RelayerTxProxy,
+		Nonce:    "1",
+		Relay:    "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		GasLimit: "100000",
+	})
+	require.NoError(t, err)
+
+	decoded, err := DecodeTransactionRequest(prepared.Request)
+	require.NoError(t, err)
+	assert.Equal(t, types.TransactionTypeProxy, decoded.Type)
+	assert.Equal(t, toProxyTransactions(decodeTestTxns), decoded.ProxyCalls)
+
+	_, err = DecodeProxyTransactionData("0xdeadbeef")
+	require.Error(t, err)
+}
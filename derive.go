@@ -1,6 +1,9 @@
 package relayer
 
-import "github.com/GoPolymarket/go-builder-relayer-client/internal/builder"
+import (
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/builder"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
 
 // DeriveSafeAddress returns the deterministic Safe address for an EOA on a supported chain.
 func DeriveSafeAddress(chainID int64, eoa string) (string, error) {
@@ -8,7 +11,7 @@ func DeriveSafeAddress(chainID int64, eoa string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return builder.DeriveSafeAddress(eoa, config.SafeContracts.SafeFactory)
+	return builder.DeriveSafeAddressWithConfig(eoa, config.SafeContracts)
 }
 
 // DeriveProxyAddress returns the deterministic Proxy address for an EOA on a supported chain.
@@ -17,5 +20,18 @@ func DeriveProxyAddress(chainID int64, eoa string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return builder.DeriveProxyWalletAddress(eoa, config.ProxyContracts.ProxyFactory)
+	return builder.DeriveProxyWalletAddressWithConfig(eoa, config.ProxyContracts)
+}
+
+// DeriveSafeAddressWithConfig returns the Safe address for an EOA using the factory and init
+// code hash in config, e.g. for a locally deployed Safe factory. An empty InitCodeHash means
+// types.SafeInitCodeHash.
+func DeriveSafeAddressWithConfig(eoa string, config types.SafeContractConfig) (string, error) {
+	return builder.DeriveSafeAddressWithConfig(eoa, config)
+}
+
+// DeriveProxyAddressWithConfig returns the proxy wallet address for an EOA using the factory and
+// init code hash in config. An empty InitCodeHash means types.ProxyInitCodeHash.
+func DeriveProxyAddressWithConfig(eoa string, config types.ProxyContractConfig) (string, error) {
+	return builder.DeriveProxyWalletAddressWithConfig(eoa, config)
 }
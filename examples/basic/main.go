@@ -3,43 +3,23 @@ package main
 import (
 	"context"
 	"fmt"
-	"os"
-	"strconv"
 
-	relayer "github.com/GoPolymarket/go-builder-relayer-client"
-	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/config"
 	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
 )
 
 func main() {
-	relayerURL := os.Getenv("POLYMARKET_RELAYER_URL")
-	chainIDStr := os.Getenv("CHAIN_ID")
-	privateKey := os.Getenv("PRIVATE_KEY")
-
-	chainID, _ := strconv.ParseInt(chainIDStr, 10, 64)
-	signerInstance, err := signer.NewPrivateKeySigner(privateKey, chainID)
+	// Reads POLYMARKET_RELAYER_URL, CHAIN_ID, PRIVATE_KEY and BUILDER_* (or a file named by
+	// POLYMARKET_RELAYER_CONFIG) and validates them.
+	cfg, err := config.Load()
 	if err != nil {
 		panic(err)
 	}
-
-	builderCfg := &relayer.BuilderConfig{}
-	if remoteHost := os.Getenv("BUILDER_REMOTE_HOST"); remoteHost != "" {
-		builderCfg.Remote = &relayer.BuilderRemoteConfig{
-			Host:  remoteHost,
-			Token: os.Getenv("BUILDER_REMOTE_TOKEN"),
-		}
-	} else {
-		builderCfg.Local = &relayer.BuilderCredentials{
-			Key:        os.Getenv("BUILDER_API_KEY"),
-			Secret:     os.Getenv("BUILDER_SECRET"),
-			Passphrase: os.Getenv("BUILDER_PASS_PHRASE"),
-		}
-	}
-	if !builderCfg.IsValid() {
-		panic("builder authentication is required: set BUILDER_REMOTE_HOST or local BUILDER_* env vars")
+	if cfg.PrivateKey.Value == "" {
+		panic("PRIVATE_KEY is required")
 	}
 
-	client, err := relayer.NewRelayClient(relayerURL, chainID, signerInstance, builderCfg, types.RelayerTxSafe)
+	client, err := cfg.NewRelayClient()
 	if err != nil {
 		panic(err)
 	}
@@ -3,45 +3,33 @@ package main
 import (
 	"context"
 	"fmt"
-	"os"
-	"strconv"
 
 	relayer "github.com/GoPolymarket/go-builder-relayer-client"
-	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/config"
 	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
 )
 
 func main() {
-	relayerURL := os.Getenv("POLYMARKET_RELAYER_URL")
-	chainIDStr := os.Getenv("CHAIN_ID")
-	privateKey := os.Getenv("PRIVATE_KEY")
-	if relayerURL == "" || chainIDStr == "" || privateKey == "" {
-		panic("POLYMARKET_RELAYER_URL, CHAIN_ID, PRIVATE_KEY are required")
-	}
-
-	chainID, err := strconv.ParseInt(chainIDStr, 10, 64)
+	cfg, err := config.Load()
 	if err != nil {
 		panic(err)
 	}
-
-	signerInstance, err := signer.NewPrivateKeySigner(privateKey, chainID)
+	signerInstance, err := cfg.Signer()
 	if err != nil {
 		panic(err)
 	}
-
-	builderCfg := buildBuilderConfig()
-	if builderCfg == nil {
-		panic("builder authentication is required: set BUILDER_REMOTE_HOST or local BUILDER_* env vars")
+	if signerInstance == nil {
+		panic("PRIVATE_KEY is required")
 	}
 
-	client, err := relayer.NewRelayClient(relayerURL, chainID, signerInstance, builderCfg, types.RelayerTxSafe)
+	client, err := cfg.NewRelayClient(relayer.WithSigner(signerInstance))
 	if err != nil {
 		panic(err)
 	}
 
 	ctx := context.Background()
 
-	safeAddress, err := relayer.DeriveSafeAddress(chainID, signerInstance.Address().Hex())
+	safeAddress, err := relayer.DeriveSafeAddress(cfg.ChainID, signerInstance.Address().Hex())
 	if err != nil {
 		panic(err)
 	}
@@ -88,18 +76,3 @@ func main() {
 	}
 	fmt.Println("Relayer transactions (authed):", len(txns))
 }
-
-func buildBuilderConfig() *relayer.BuilderConfig {
-	if host := os.Getenv("BUILDER_REMOTE_HOST"); host != "" {
-		return &relayer.BuilderConfig{Remote: &relayer.BuilderRemoteConfig{Host: host, Token: os.Getenv("BUILDER_REMOTE_TOKEN")}}
-	}
-
-	key := os.Getenv("BUILDER_API_KEY")
-	secret := os.Getenv("BUILDER_SECRET")
-	passphrase := os.Getenv("BUILDER_PASS_PHRASE")
-	if key == "" || secret == "" || passphrase == "" {
-		return nil
-	}
-
-	return &relayer.BuilderConfig{Local: &relayer.BuilderCredentials{Key: key, Secret: secret, Passphrase: passphrase}}
-}
@@ -0,0 +1,136 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/encoder"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// DelegateCallPolicy controls which contracts may be targeted by DelegateCall operations
+// submitted through ExecuteSafe and ExecuteProxy. A delegate call runs the target's code
+// with the wallet's storage and funds, so by default ExecuteSafe only allows the configured
+// Safe MultiSend contract, and only when every DelegateCall packed inside the multisend is
+// allowed too. ExecuteProxy allows no targets by default.
+type DelegateCallPolicy struct {
+	// AllowedTargets lists additional contract addresses that may be delegate-called.
+	AllowedTargets []string
+	// AllowAny disables the allowlist. Only enable it if every target is trusted.
+	AllowAny bool
+}
+
+// SetDelegateCallPolicy replaces the delegate call guardrails used by ExecuteSafe and ExecuteProxy.
+func (c *RelayClient) SetDelegateCallPolicy(policy DelegateCallPolicy) {
+	c.delegateCallPolicy = policy
+}
+
+func (c *RelayClient) delegateCallAllowed(target string) bool {
+	if c.delegateCallPolicy.AllowAny {
+		return true
+	}
+	if !common.IsHexAddress(target) {
+		return false
+	}
+	addr := common.HexToAddress(target)
+	for _, allowed := range c.delegateCallPolicy.AllowedTargets {
+		if common.IsHexAddress(allowed) && common.HexToAddress(allowed) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSafeDelegateCall applies the DelegateCallPolicy to a Safe DelegateCall. A call to the
+// Safe MultiSend contract is allowed when every DelegateCall packed inside it is.
+func (c *RelayClient) checkSafeDelegateCall(tx types.SafeTransaction) error {
+	if c.delegateCallAllowed(tx.To) {
+		return nil
+	}
+	multisend := c.contractConfig.SafeContracts.SafeMultisend
+	if multisend == "" || !common.IsHexAddress(tx.To) || common.HexToAddress(multisend) != common.HexToAddress(tx.To) {
+		return fmt.Errorf("%w: %s", types.ErrDelegateCallDenied, tx.To)
+	}
+	inner, err := encoder.DecodeSafeMultisendData(tx.Data)
+	if err != nil {
+		return fmt.Errorf("%w: multisend %s: %v", types.ErrDelegateCallDenied, tx.To, err)
+	}
+	for i, innerTx := range inner {
+		switch innerTx.Operation {
+		case types.OperationCall:
+		case types.OperationDelegateCall:
+			if err := c.checkSafeDelegateCall(innerTx); err != nil {
+				return fmt.Errorf("multisend entry %d: %w", i, err)
+			}
+		default:
+			return fmt.Errorf("%w: multisend entry %d has operation %d", types.ErrInvalidOperation, i, innerTx.Operation)
+		}
+	}
+	return nil
+}
+
+// ExecuteSafe executes Safe transactions with explicit operation types.
+// DelegateCall operations are refused unless the target is allowed by the DelegateCallPolicy
+// or is the Safe MultiSend contract with only allowed DelegateCalls inside.
+func (c *RelayClient) ExecuteSafe(ctx context.Context, txns []types.SafeTransaction, metadata string, opts ...ExecuteOption) (*ClientRelayerTransactionResponse, error) {
+	if c.signer == nil {
+		return nil, types.ErrSignerUnavailable
+	}
+	if len(txns) == 0 {
+		return nil, types.ErrNoTransactions
+	}
+	normalized := make([]types.SafeTransaction, 0, len(txns))
+	for i, tx := range txns {
+		switch tx.Operation {
+		case types.OperationCall:
+		case types.OperationDelegateCall:
+			if err := c.checkSafeDelegateCall(tx); err != nil {
+				return nil, fmt.Errorf("transaction %d: %w", i, err)
+			}
+		default:
+			return nil, fmt.Errorf("%w: transaction %d has operation %d", types.ErrInvalidOperation, i, tx.Operation)
+		}
+		if strings.TrimSpace(tx.Value) == "" {
+			tx.Value = "0"
+		}
+		normalized = append(normalized, tx)
+	}
+
+	return c.executeIdempotent(ctx, metadata, opts, func(ctx context.Context, metadata string) (*PreparedTransaction, error) {
+		return c.prepareSafeTransactions(ctx, normalized, metadata, true)
+	})
+}
+
+// ExecuteProxy executes proxy wallet transactions with explicit call types.
+// DelegateCall call types are refused unless the target is allowed by the DelegateCallPolicy.
+func (c *RelayClient) ExecuteProxy(ctx context.Context, txns []types.ProxyTransaction, metadata string, opts ...ExecuteOption) (*ClientRelayerTransactionResponse, error) {
+	if c.signer == nil {
+		return nil, types.ErrSignerUnavailable
+	}
+	if len(txns) == 0 {
+		return nil, types.ErrNoTransactions
+	}
+	normalized := make([]types.ProxyTransaction, 0, len(txns))
+	for i, tx := range txns {
+		switch tx.TypeCode {
+		case types.CallTypeCall:
+		case types.CallTypeDelegateCall:
+			if !c.delegateCallAllowed(tx.To) {
+				return nil, fmt.Errorf("%w: transaction %d to %s", types.ErrDelegateCallDenied, i, tx.To)
+			}
+		default:
+			return nil, fmt.Errorf("%w: transaction %d has call type %d", types.ErrInvalidOperation, i, tx.TypeCode)
+		}
+		if strings.TrimSpace(tx.Value) == "" {
+			tx.Value = "0"
+		}
+		normalized = append(normalized, tx)
+	}
+
+	return c.executeIdempotent(ctx, metadata, opts, func(ctx context.Context, metadata string) (*PreparedTransaction, error) {
+		return c.prepareProxyTransactions(ctx, normalized, metadata)
+	})
+}
@@ -0,0 +1,100 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/encoder"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestExecuteSafe_RefusesDelegateCallOutsideAllowlist(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newFakeRelayer(t, nil)
+	target := "0x9999999999999999999999999999999999999999"
+	txns := []types.SafeTransaction{{To: target, Operation: types.OperationDelegateCall, Data: "0x"}}
+
+	_, err := client.ExecuteSafe(context.Background(), txns, "")
+	require.ErrorIs(t, err, types.ErrDelegateCallDenied)
+
+	_, err = client.ExecuteSafe(context.Background(), []types.SafeTransaction{{To: target, Operation: 7}}, "")
+	require.ErrorIs(t, err, types.ErrInvalidOperation)
+
+	client.SetDelegateCallPolicy(DelegateCallPolicy{AllowedTargets: []string{target}})
+	_, err = client.ExecuteSafe(context.Background(), txns, "")
+	require.NoError(t, err)
+}
+
+func TestExecuteSafe_SubmitsDelegateCallOperation(t *testing.T) {
+	t.Parallel()
+
+	var submitted types.TransactionRequest
+	client, _ := newFakeRelayer(t, map[string]http.HandlerFunc{
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			submitted = submittedRequest(r)
+			respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`)(w, r)
+		},
+	})
+	client.SetDelegateCallPolicy(DelegateCallPolicy{AllowAny: true})
+
+	resp, err := client.ExecuteSafe(context.Background(), []types.SafeTransaction{{
+		To:        "0x9999999999999999999999999999999999999999",
+		Operation: types.OperationDelegateCall,
+		Data:      "0x",
+	}}, "delegate")
+	require.NoError(t, err)
+	assert.Equal(t, "tx-1", resp.TransactionID)
+	assert.Equal(t, "1", submitted.SignatureParams.Operation)
+	assert.Equal(t, "0x9999999999999999999999999999999999999999", submitted.To)
+}
+
+func TestExecuteSafe_ChecksDelegateCallsInsideMultisend(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newFakeRelayer(t, nil)
+	multisendAddress := client.contractConfig.SafeContracts.SafeMultisend
+	target := "0x9999999999999999999999999999999999999999"
+	call := types.SafeTransaction{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Operation: types.OperationCall, Data: "0x", Value: "0"}
+	delegate := types.SafeTransaction{To: target, Operation: types.OperationDelegateCall, Data: "0x", Value: "0"}
+
+	calls, err := encoder.CreateSafeMultisendTransaction([]types.SafeTransaction{call, call}, multisendAddress)
+	require.NoError(t, err)
+	_, err = client.ExecuteSafe(context.Background(), []types.SafeTransaction{calls}, "")
+	require.NoError(t, err)
+
+	smuggled, err := encoder.CreateSafeMultisendTransaction([]types.SafeTransaction{call, delegate}, multisendAddress)
+	require.NoError(t, err)
+	_, err = client.ExecuteSafe(context.Background(), []types.SafeTransaction{smuggled}, "")
+	require.ErrorIs(t, err, types.ErrDelegateCallDenied)
+
+	nested, err := encoder.CreateSafeMultisendTransaction([]types.SafeTransaction{smuggled}, multisendAddress)
+	require.NoError(t, err)
+	_, err = client.ExecuteSafe(context.Background(), []types.SafeTransaction{nested}, "")
+	require.ErrorIs(t, err, types.ErrDelegateCallDenied)
+
+	_, err = client.ExecuteSafe(context.Background(), []types.SafeTransaction{{To: multisendAddress, Operation: types.OperationDelegateCall, Data: "0x1234"}}, "")
+	require.ErrorIs(t, err, types.ErrDelegateCallDenied)
+
+	client.SetDelegateCallPolicy(DelegateCallPolicy{AllowedTargets: []string{target}})
+	_, err = client.ExecuteSafe(context.Background(), []types.SafeTransaction{nested}, "")
+	require.NoError(t, err)
+}
+
+func TestExecuteProxy_RefusesDelegateCallToSafeMultisend(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewRelayClient("https://example.test", 137, newTestSigner(), testBuilderConfig(), types.RelayerTxProxy)
+	require.NoError(t, err)
+
+	_, err = client.ExecuteProxy(context.Background(), []types.ProxyTransaction{{
+		To:       client.contractConfig.SafeContracts.SafeMultisend,
+		TypeCode: types.CallTypeDelegateCall,
+		Data:     "0x",
+	}}, "")
+	require.ErrorIs(t, err, types.ErrDelegateCallDenied)
+}
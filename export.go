@@ -0,0 +1,213 @@
+package relayer
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// ExportFormat selects the output encoding of ExportTransactions.
+type ExportFormat string
+
+const (
+	// ExportCSV writes one row per inner call, repeating the transaction columns.
+	ExportCSV ExportFormat = "csv"
+	// ExportJSONL writes one ExportRecord per line.
+	ExportJSONL ExportFormat = "jsonl"
+)
+
+var exportCSVHeader = []string{
+	"transaction_id", "transaction_hash", "state", "type", "from", "to", "proxy_address",
+	"nonce", "value", "metadata", "created_at", "updated_at",
+	"call_index", "call_to", "call_operation", "call_value", "call_data", "decode_error",
+}
+
+// ExportCheckpoint marks how far an export got. Persist it between runs to export incrementally.
+type ExportCheckpoint struct {
+	// Since is the creation time of the newest exported transaction.
+	Since time.Time `json:"since"`
+	// IDs lists the exported transactions created exactly at Since.
+	IDs []string `json:"ids,omitempty"`
+}
+
+// ExportOptions configures ExportTransactions.
+type ExportOptions struct {
+	Format ExportFormat
+	Filter TransactionFilter
+	// Checkpoint skips transactions exported by a previous run.
+	Checkpoint ExportCheckpoint
+	// TerminalOnly exports transactions only once they are CONFIRMED, FAILED or INVALID. The
+	// export stops at the oldest transaction still in flight so that it is picked up later.
+	TerminalOnly bool
+	// NoHeader omits the CSV header row, e.g. when appending to the file of a previous run.
+	NoHeader bool
+}
+
+// ExportResult reports what ExportTransactions wrote.
+type ExportResult struct {
+	Exported   int
+	Checkpoint ExportCheckpoint
+}
+
+// ExportCall is a decoded inner call of an exported transaction.
+type ExportCall struct {
+	To        string `json:"to"`
+	Operation string `json:"operation"`
+	Value     string `json:"value"`
+	Data      string `json:"data"`
+}
+
+// ExportRecord is one exported transaction.
+type ExportRecord struct {
+	types.RelayerTransaction
+	Calls       []ExportCall `json:"calls,omitempty"`
+	DecodeError string       `json:"decodeError,omitempty"`
+}
+
+// ExportTransactions writes the builder's relayer transactions newer than opts.Checkpoint to w,
+// oldest first, with their inner calls decoded, and returns the checkpoint for the next run.
+func (c *RelayClient) ExportTransactions(ctx context.Context, w io.Writer, opts ExportOptions) (*ExportResult, error) {
+	if opts.Format == "" {
+		opts.Format = ExportJSONL
+	}
+	if opts.Format != ExportCSV && opts.Format != ExportJSONL {
+		return nil, fmt.Errorf("unsupported export format: %q", opts.Format)
+	}
+
+	checkpoint := opts.Checkpoint
+	seen := make(map[string]struct{}, len(checkpoint.IDs))
+	for _, id := range checkpoint.IDs {
+		seen[id] = struct{}{}
+	}
+
+	filter := opts.Filter
+	if !checkpoint.Since.IsZero() {
+		// Let the relayer skip what was already exported; CreatedAfter is exclusive.
+		if since := checkpoint.Since.Add(-time.Nanosecond); since.After(filter.CreatedAfter) {
+			filter.CreatedAfter = since
+		}
+	}
+
+	var txns []types.RelayerTransaction
+	for txn, err := range c.TransactionsSeq(ctx, GetTransactionsOptions{Filter: filter}) {
+		if err != nil {
+			return nil, err
+		}
+		if txn.CreatedAt.Before(checkpoint.Since) {
+			continue
+		}
+		if _, ok := seen[txn.TransactionID]; ok && txn.CreatedAt.Equal(checkpoint.Since) {
+			continue
+		}
+		txns = append(txns, txn)
+	}
+	sort.SliceStable(txns, func(i, j int) bool {
+		if !txns[i].CreatedAt.Equal(txns[j].CreatedAt) {
+			return txns[i].CreatedAt.Before(txns[j].CreatedAt)
+		}
+		return txns[i].TransactionID < txns[j].TransactionID
+	})
+	if opts.TerminalOnly {
+		for i, txn := range txns {
+			if !txn.State.IsTerminal() {
+				txns = txns[:i]
+				break
+			}
+		}
+	}
+
+	var csvWriter *csv.Writer
+	var jsonEncoder *json.Encoder
+	if opts.Format == ExportCSV {
+		csvWriter = csv.NewWriter(w)
+		if !opts.NoHeader {
+			if err := csvWriter.Write(exportCSVHeader); err != nil {
+				return nil, fmt.Errorf("write export: %w", err)
+			}
+		}
+	} else {
+		jsonEncoder = json.NewEncoder(w)
+	}
+
+	result := &ExportResult{Checkpoint: checkpoint}
+	for _, txn := range txns {
+		record := newExportRecord(txn)
+		var err error
+		if csvWriter != nil {
+			err = csvWriter.WriteAll(record.csvRows())
+		} else {
+			err = jsonEncoder.Encode(record)
+		}
+		if err != nil {
+			return result, fmt.Errorf("write export: %w", err)
+		}
+
+		result.Exported++
+		if txn.CreatedAt.Equal(result.Checkpoint.Since) {
+			result.Checkpoint.IDs = append(slices.Clip(result.Checkpoint.IDs), txn.TransactionID)
+		} else {
+			result.Checkpoint = ExportCheckpoint{Since: txn.CreatedAt, IDs: []string{txn.TransactionID}}
+		}
+	}
+	if csvWriter != nil {
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return result, fmt.Errorf("write export: %w", err)
+		}
+	}
+	return result, nil
+}
+
+func newExportRecord(txn types.RelayerTransaction) ExportRecord {
+	record := ExportRecord{RelayerTransaction: txn}
+	decoded, err := DecodeRelayerTransaction(txn)
+	if err != nil {
+		record.DecodeError = err.Error()
+		return record
+	}
+	for _, call := range decoded.SafeCalls {
+		operation := "CALL"
+		if call.Operation == types.OperationDelegateCall {
+			operation = "DELEGATECALL"
+		}
+		record.Calls = append(record.Calls, ExportCall{To: call.To, Operation: operation, Value: call.Value, Data: call.Data})
+	}
+	for _, call := range decoded.ProxyCalls {
+		operation := "CALL"
+		if call.TypeCode == types.CallTypeDelegateCall {
+			operation = "DELEGATECALL"
+		}
+		record.Calls = append(record.Calls, ExportCall{To: call.To, Operation: operation, Value: call.Value, Data: call.Data})
+	}
+	return record
+}
+
+func (r ExportRecord) csvRows() [][]string {
+	base := []string{
+		r.TransactionID, r.TransactionHash, string(r.State), r.Type, r.From, r.To, r.ProxyAddress,
+		r.Nonce, r.Value, r.Metadata, formatExportTime(r.CreatedAt), formatExportTime(r.UpdatedAt),
+	}
+	if len(r.Calls) == 0 {
+		return [][]string{append(slices.Clone(base), "", "", "", "", "", r.DecodeError)}
+	}
+	rows := make([][]string, 0, len(r.Calls))
+	for i, call := range r.Calls {
+		rows = append(rows, append(slices.Clone(base), strconv.Itoa(i), call.To, call.Operation, call.Value, call.Data, r.DecodeError))
+	}
+	return rows
+}
+
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
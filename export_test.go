@@ -0,0 +1,91 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func newExportTestClient(t *testing.T, txns *[]types.RelayerTransaction) *RelayClient {
+	t.Helper()
+
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, err := json.Marshal(*txns)
+		require.NoError(t, err)
+		return newResponse(http.StatusOK, string(body), nil), nil
+	})}))
+	return client
+}
+
+func TestExportTransactions_IncrementalJSONL(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSigner()
+	prepared, err := SignTransactionRequest(context.Background(), s, decodeTestTxns, OfflineSignParams{ChainID: 137, Nonce: "1"})
+	require.NoError(t, err)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	txns := []types.RelayerTransaction{
+		{TransactionID: "b", Type: "SAFE", To: prepared.Request.To, Data: prepared.Request.Data, State: types.StateConfirmed, CreatedAt: base.Add(time.Minute)},
+		{TransactionID: "a", Type: "SAFE", To: prepared.Request.To, Data: "0x", State: types.StateFailed, CreatedAt: base},
+		{TransactionID: "c", Type: "SAFE", State: types.StateMined, CreatedAt: base.Add(2 * time.Minute)},
+	}
+	client := newExportTestClient(t, &txns)
+
+	var out bytes.Buffer
+	result, err := client.ExportTransactions(context.Background(), &out, ExportOptions{TerminalOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Exported)
+	assert.Equal(t, ExportCheckpoint{Since: base.Add(time.Minute), IDs: []string{"b"}}, result.Checkpoint)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	var record ExportRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "b", record.TransactionID)
+	require.Len(t, record.Calls, 2)
+	assert.Equal(t, decodeTestTxns[1].To, record.Calls[1].To)
+	assert.Equal(t, "CALL", record.Calls[1].Operation)
+
+	// c becomes terminal and a new transaction shares b's timestamp.
+	txns[2].State = types.StateConfirmed
+	txns = append(txns, types.RelayerTransaction{TransactionID: "d", Type: "SAFE", State: types.StateInvalid, CreatedAt: base.Add(time.Minute)})
+	out.Reset()
+	result, err = client.ExportTransactions(context.Background(), &out, ExportOptions{Checkpoint: result.Checkpoint, TerminalOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Exported)
+	assert.Contains(t, out.String(), `"transactionID":"d"`)
+	assert.Contains(t, out.String(), `"transactionID":"c"`)
+	assert.Equal(t, ExportCheckpoint{Since: base.Add(2 * time.Minute), IDs: []string{"c"}}, result.Checkpoint)
+}
+
+func TestExportTransactions_CSVRowPerCall(t *testing.T) {
+	t.Parallel()
+
+	txns := []types.RelayerTransaction{{TransactionID: "a", Type: "PROXY", Data: "0xdeadbeef", State: types.StateMined, Metadata: "redeem"}}
+	client := newExportTestClient(t, &txns)
+
+	var out bytes.Buffer
+	_, err := client.ExportTransactions(context.Background(), &out, ExportOptions{Format: ExportCSV})
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, exportCSVHeader, rows[0])
+	assert.Equal(t, "a", rows[1][0])
+	assert.Equal(t, "redeem", rows[1][9])
+	assert.NotEmpty(t, rows[1][len(rows[1])-1], fmt.Sprintf("decode error expected in %v", rows[1]))
+}
@@ -0,0 +1,90 @@
+package relayer
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+var testSignerAddress = common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+func newTestSigner() *captureEstimateSigner {
+	return &captureEstimateSigner{address: testSignerAddress}
+}
+
+// fakeRelayer serves relayer endpoints in memory. Endpoints without a handler answer like a
+// relayer with a deployed Safe at nonce 3 that accepts every submission as tx-1; any other
+// path is 404. Handlers run one at a time.
+type fakeRelayer struct {
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	calls    map[string]int
+}
+
+// newFakeRelayer returns a Safe RelayClient with builder credentials that talks to a
+// fakeRelayer using handlers to override endpoints by path.
+func newFakeRelayer(t *testing.T, handlers map[string]http.HandlerFunc, opts ...HTTPClientOption) (*RelayClient, *fakeRelayer) {
+	t.Helper()
+
+	f := &fakeRelayer{
+		handlers: map[string]http.HandlerFunc{
+			GetDeployedEndpoint:       respond(http.StatusOK, `{"deployed":true}`),
+			GetNonceEndpoint:          respond(http.StatusOK, `{"nonce":"3"}`),
+			SubmitTransactionEndpoint: respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`),
+		},
+		calls: map[string]int{},
+	}
+	for path, handler := range handlers {
+		f.handlers[path] = handler
+	}
+
+	client, err := NewRelayClient("https://example.test", 137, newTestSigner(), testBuilderConfig(), types.RelayerTxSafe)
+	require.NoError(t, err)
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: f}, opts...))
+	return client, f
+}
+
+func (f *fakeRelayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[req.URL.Path]++
+	handler, ok := f.handlers[req.URL.Path]
+	if !ok {
+		handler = respond(http.StatusNotFound, `{"error":"not found"}`)
+	}
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	return rec.Result(), nil
+}
+
+// Calls returns how many requests reached path.
+func (f *fakeRelayer) Calls(path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[path]
+}
+
+// respond answers every request with status and a JSON body.
+func respond(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}
+}
+
+// submittedRequest decodes the transaction request POSTed to SubmitTransactionEndpoint.
+func submittedRequest(r *http.Request) types.TransactionRequest {
+	var submitted types.TransactionRequest
+	body, _ := io.ReadAll(r.Body)
+	_ = json.Unmarshal(body, &submitted)
+	return submitted
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -28,12 +30,23 @@ type RequestOptions struct {
 	Headers http.Header
 	Params  map[string]string
 	Body    []byte
+	// Endpoint identifies the relayer endpoint (e.g. SubmitTransactionEndpoint) for per-endpoint
+	// policies. Defaults to the URL path.
+	Endpoint string
+	// Retry restricts which failures are retried. Nil retries all network errors, 5xx and 429.
+	Retry *RetryPolicy
+	// Interceptors run for this request only, after those of the HTTPClient.
+	Interceptors []Interceptor
 }
 
 type HTTPClient struct {
-	client     *http.Client
-	maxRetries uint
-	baseDelay  time.Duration
+	client       *http.Client
+	maxRetries   uint
+	baseDelay    time.Duration
+	breakers     *circuitBreakers
+	limiter      *rateLimiter
+	interceptors []Interceptor
+	logger       logger.Logger
 }
 
 // HTTPClientOption configures an HTTPClient.
@@ -44,6 +57,11 @@ func WithMaxRetries(n uint) HTTPClientOption {
 	return func(c *HTTPClient) { c.maxRetries = n }
 }
 
+// WithHTTPLogger sets the logger for retry warnings and errors. Defaults to logger.GetDefault().
+func WithHTTPLogger(l logger.Logger) HTTPClientOption {
+	return func(c *HTTPClient) { c.logger = l }
+}
+
 // WithBaseDelay sets the base delay for exponential backoff between retries.
 func WithBaseDelay(d time.Duration) HTTPClientOption {
 	return func(c *HTTPClient) { c.baseDelay = d }
@@ -64,10 +82,28 @@ func NewHTTPClient(client *http.Client, opts ...HTTPClientOption) *HTTPClient {
 	return hc
 }
 
+func (c *HTTPClient) log() logger.Logger {
+	if c.logger != nil {
+		return c.logger
+	}
+	return logger.GetDefault()
+}
+
+// CircuitState returns the circuit breaker state for a relayer host.
+// It reports CircuitClosed when no circuit breaker is configured.
+func (c *HTTPClient) CircuitState(host string) CircuitState {
+	if c == nil || c.breakers == nil {
+		return CircuitClosed
+	}
+	return c.breakers.state(host)
+}
+
 type HTTPError struct {
 	StatusCode int
 	Body       string
 	Err        *sdkerrors.SDKError
+	// API is the decoded relayer error payload, if the body contained one.
+	API *RelayerAPIError
 }
 
 func (e *HTTPError) Error() string {
@@ -85,6 +121,38 @@ func (e *HTTPError) Unwrap() error {
 	return e.Err
 }
 
+// transportError is a network failure annotated with whether the request headers had
+// been written, i.e. whether the server may have received the request.
+type transportError struct {
+	sent bool
+	err  error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+
+func (e *transportError) Unwrap() error { return e.err }
+
+// Is reports whether the relayer error payload matches target, so callers can
+// errors.Is on specific failure reasons such as sdkerrors.ErrNonceMismatch.
+func (e *HTTPError) Is(target error) bool {
+	if e == nil || e.API == nil || e.API.Err == nil {
+		return false
+	}
+	return errors.Is(e.API.Err, target)
+}
+
+// As exposes the decoded relayer error payload to errors.As.
+func (e *HTTPError) As(target interface{}) bool {
+	if e == nil || e.API == nil {
+		return false
+	}
+	if p, ok := target.(**RelayerAPIError); ok {
+		*p = e.API
+		return true
+	}
+	return false
+}
+
 func (e *HTTPError) Code() sdkerrors.ErrorCode {
 	if e == nil || e.Err == nil {
 		return ""
@@ -164,29 +232,57 @@ func (c *HTTPClient) Do(ctx context.Context, method, urlStr string, opts *Reques
 		parsed.RawQuery = q.Encode()
 	}
 
+	endpoint := opts.Endpoint
+	if endpoint == "" {
+		endpoint = parsed.Path
+	}
+
+	var breaker *circuitBreaker
+	if c.breakers != nil {
+		breaker = c.breakers.get(parsed.Host)
+	}
+
+	policy := opts.Retry
+	maxRetries := c.maxRetries
+	if policy != nil && policy.MaxRetries > 0 {
+		maxRetries = policy.MaxRetries
+	}
+
+	interceptors := c.interceptors
+	if len(opts.Interceptors) > 0 {
+		interceptors = append(slices.Clip(interceptors), opts.Interceptors...)
+	}
+
 	var lastErr error
-	maxAttempts := c.maxRetries + 1
+	maxAttempts := maxRetries + 1
 	var nextRetryDelay *time.Duration
+	var info RequestInfo
 
-	for attempt := uint(0); attempt <= c.maxRetries; attempt++ {
+	for attempt := uint(0); attempt <= maxRetries; attempt++ {
 		if attempt > 0 {
 			delay := exponentialBackoff(c.baseDelay, attempt-1)
 			if nextRetryDelay != nil {
 				delay = *nextRetryDelay
 				nextRetryDelay = nil
 			}
+			onRetry(ctx, interceptors, info, delay, lastErr)
 			if err := sleepWithContext(ctx, delay); err != nil {
 				return err
 			}
 		}
 
+		if err := c.limiter.wait(ctx, endpoint); err != nil {
+			return err
+		}
+
 		// Re-create body reader for each attempt
 		var bodyReader io.Reader
 		if len(opts.Body) > 0 {
 			bodyReader = bytes.NewReader(opts.Body)
 		}
 
-		req, err := http.NewRequestWithContext(ctx, method, parsed.String(), bodyReader)
+		reqCtx, sent := traceRequestSent(ctx)
+		req, err := http.NewRequestWithContext(reqCtx, method, parsed.String(), bodyReader)
 		if err != nil {
 			return fmt.Errorf("build request: %w", err)
 		}
@@ -204,11 +300,34 @@ func (c *HTTPClient) Do(ctx context.Context, method, urlStr string, opts *Reques
 			}
 		}
 
+		info = RequestInfo{Method: method, Endpoint: endpoint, URL: req.URL.String(), Attempt: attempt + 1}
+		if len(interceptors) > 0 {
+			info.Headers = redactHeaders(req.Header)
+			if err := beforeSend(ctx, interceptors, info, req.Header); err != nil {
+				return err
+			}
+		}
+
+		var generation uint64
+		if breaker != nil {
+			generation, err = breaker.allow()
+			if err != nil {
+				afterReceive(ctx, interceptors, info, ResponseInfo{Err: err})
+				return err
+			}
+		}
+
+		start := time.Now()
 		resp, err := c.client.Do(req)
 		if err != nil {
-			lastErr = fmt.Errorf("request failed: %w", err)
-			if attempt < c.maxRetries {
-				logger.Warn("%s %s: request failed (attempt %d/%d): %v", method, urlStr, attempt+1, maxAttempts, err)
+			afterReceive(ctx, interceptors, info, ResponseInfo{Latency: time.Since(start), Err: err})
+			breaker.record(generation, networkCircuitResult(ctx))
+			lastErr = fmt.Errorf("request failed: %w", &transportError{sent: sent.Load(), err: err})
+			if !policy.retryNetworkError(sent.Load()) {
+				return lastErr
+			}
+			if attempt < maxRetries {
+				c.log().Warn("%s %s: request failed (attempt %d/%d): %v", method, urlStr, attempt+1, maxAttempts, err)
 			}
 			continue // Retry on network errors
 		}
@@ -218,29 +337,54 @@ func (c *HTTPClient) Do(ctx context.Context, method, urlStr string, opts *Reques
 			err = closeErr
 		}
 
+		afterReceive(ctx, interceptors, info, ResponseInfo{StatusCode: resp.StatusCode, Header: resp.Header, Latency: time.Since(start), Err: err})
+
 		if err != nil {
-			lastErr = fmt.Errorf("read response: %w", err)
-			if attempt < c.maxRetries {
-				logger.Warn("%s %s: read response failed (attempt %d/%d): %v", method, urlStr, attempt+1, maxAttempts, err)
+			breaker.record(generation, networkCircuitResult(ctx))
+			lastErr = fmt.Errorf("read response: %w", &transportError{sent: true, err: err})
+			if !policy.retryNetworkError(true) {
+				return lastErr
+			}
+			if attempt < maxRetries {
+				c.log().Warn("%s %s: read response failed (attempt %d/%d): %v", method, urlStr, attempt+1, maxAttempts, err)
 			}
 			continue
 		}
 
+		if resp.StatusCode >= 500 {
+			breaker.record(generation, circuitFailure)
+		} else {
+			breaker.record(generation, circuitSuccess)
+		}
+
 		httpErr := &HTTPError{
 			StatusCode: resp.StatusCode,
 			Body:       string(respBytes),
 			Err:        httpErrorForStatus(resp.StatusCode),
 		}
+		if resp.StatusCode >= 400 {
+			httpErr.API = parseRelayerAPIError(respBytes)
+		}
 
 		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
 			lastErr = httpErr
-			if attempt < c.maxRetries {
-				if resp.StatusCode == http.StatusTooManyRequests {
-					if retryDelay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
-						nextRetryDelay = &retryDelay
+			var retryAfter *time.Duration
+			if resp.StatusCode == http.StatusTooManyRequests {
+				if retryDelay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+					// Make every caller sharing this client back off, not only this one.
+					if c.limiter.pause(endpoint, retryDelay) {
+						// limiter.wait holds the next attempt until the pause ends; don't sleep twice.
+						retryDelay = 0
 					}
+					retryAfter = &retryDelay
 				}
-				logger.Warn("%s %s: retryable status %d (attempt %d/%d)", method, urlStr, resp.StatusCode, attempt+1, maxAttempts)
+			}
+			if !policy.retryStatus(resp.StatusCode) {
+				return httpErr
+			}
+			if attempt < maxRetries {
+				nextRetryDelay = retryAfter
+				c.log().Warn("%s %s: retryable status %d (attempt %d/%d)", method, urlStr, resp.StatusCode, attempt+1, maxAttempts)
 			}
 			continue
 		}
@@ -260,7 +404,7 @@ func (c *HTTPClient) Do(ctx context.Context, method, urlStr string, opts *Reques
 	}
 
 	if lastErr != nil {
-		logger.Error("http request failed after retries: %v", lastErr)
+		c.log().Error("http request failed after retries: %v", lastErr)
 		return fmt.Errorf("max retries exceeded: %w", lastErr)
 	}
 	return fmt.Errorf("max retries exceeded")
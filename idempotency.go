@@ -0,0 +1,260 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+const (
+	idempotencyMetadataPrefix = "idempotency-key:"
+	idempotencyRecordTTL      = 24 * time.Hour
+	idempotencyRecordLimit    = 1024
+)
+
+// ExecuteOption customises a single Execute, ExecuteSafe or ExecuteProxy call.
+type ExecuteOption func(*executeOptions)
+
+type executeOptions struct {
+	idempotent     bool
+	idempotencyKey string
+}
+
+// WithIdempotencyKey makes the call idempotent. The key is embedded in the transaction
+// metadata as "idempotency-key:<key>"; before submitting, the client checks its record of
+// recent submissions and then GetTransactions, and returns the existing transaction instead
+// of relaying it again. Transactions that ended FAILED or INVALID do not count. When the
+// submission fails with a 5xx or with a transport error after the request was sent, the key is
+// checked again and, if the relayer has no record of it yet, the same signed request is resent
+// once: it carries the same nonce, so at most one of the two can be executed. The key must be
+// non-empty and contain no whitespace.
+func WithIdempotencyKey(key string) ExecuteOption {
+	return func(o *executeOptions) { o.idempotent, o.idempotencyKey = true, key }
+}
+
+func collectExecuteOptions(opts []ExecuteOption) executeOptions {
+	var o executeOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// idempotencyRecord remembers recent submissions by idempotency key and serialises
+// concurrent calls that share a key.
+type idempotencyRecord struct {
+	mu       sync.Mutex
+	entries  map[string]idempotencyEntry
+	inflight map[string]chan struct{}
+	now      func() time.Time
+}
+
+type idempotencyEntry struct {
+	transactionID   string
+	state           string
+	transactionHash string
+	at              time.Time
+}
+
+func newIdempotencyRecord() *idempotencyRecord {
+	return &idempotencyRecord{
+		entries:  make(map[string]idempotencyEntry),
+		inflight: make(map[string]chan struct{}),
+		now:      time.Now,
+	}
+}
+
+// acquire waits until no other call holds key and returns the function that releases it.
+func (r *idempotencyRecord) acquire(ctx context.Context, key string) (func(), error) {
+	for {
+		r.mu.Lock()
+		wait, busy := r.inflight[key]
+		if !busy {
+			done := make(chan struct{})
+			r.inflight[key] = done
+			r.mu.Unlock()
+			return func() {
+				r.mu.Lock()
+				delete(r.inflight, key)
+				r.mu.Unlock()
+				close(done)
+			}, nil
+		}
+		r.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+func (r *idempotencyRecord) lookup(key string) (idempotencyEntry, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.entries[key]
+	if ok && r.now().Sub(entry.at) > idempotencyRecordTTL {
+		delete(r.entries, key)
+		return idempotencyEntry{}, false
+	}
+	return entry, ok
+}
+
+func (r *idempotencyRecord) store(key string, resp *ClientRelayerTransactionResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if len(r.entries) >= idempotencyRecordLimit {
+		for k, entry := range r.entries {
+			if now.Sub(entry.at) > idempotencyRecordTTL {
+				delete(r.entries, k)
+			}
+		}
+		if len(r.entries) >= idempotencyRecordLimit {
+			r.evictOldest()
+		}
+	}
+	r.entries[key] = idempotencyEntry{
+		transactionID:   resp.TransactionID,
+		state:           resp.State,
+		transactionHash: resp.TransactionHash,
+		at:              now,
+	}
+}
+
+func (r *idempotencyRecord) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for k, entry := range r.entries {
+		if oldestKey == "" || entry.at.Before(oldest) {
+			oldestKey, oldest = k, entry.at
+		}
+	}
+	delete(r.entries, oldestKey)
+}
+
+// withIdempotencyKey appends the idempotency marker to metadata.
+func withIdempotencyKey(metadata, key string) string {
+	marker := idempotencyMetadataPrefix + key
+	if strings.TrimSpace(metadata) == "" {
+		return marker
+	}
+	return metadata + " " + marker
+}
+
+// hasIdempotencyKey reports whether metadata carries the marker for key.
+func hasIdempotencyKey(metadata, key string) bool {
+	marker := idempotencyMetadataPrefix + key
+	for _, field := range strings.Fields(metadata) {
+		if field == marker {
+			return true
+		}
+	}
+	return false
+}
+
+// executeIdempotent prepares and submits a transaction at most once per idempotency key,
+// returning the existing transaction when one was already relayed. Without a key it just
+// calls prepareAndSubmit.
+func (c *RelayClient) executeIdempotent(ctx context.Context, metadata string, opts []ExecuteOption, prepare func(ctx context.Context, metadata string) (*PreparedTransaction, error)) (*ClientRelayerTransactionResponse, error) {
+	o := collectExecuteOptions(opts)
+	if !o.idempotent {
+		return c.prepareAndSubmit(ctx, func(ctx context.Context) (*PreparedTransaction, error) {
+			return prepare(ctx, metadata)
+		})
+	}
+	key := o.idempotencyKey
+	if key == "" || strings.ContainsFunc(key, unicode.IsSpace) {
+		return nil, fmt.Errorf("%w: %q", types.ErrInvalidIdempotencyKey, key)
+	}
+
+	release, err := c.idempotency.acquire(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if entry, ok := c.idempotency.lookup(key); ok {
+		return &ClientRelayerTransactionResponse{
+			TransactionID:   entry.transactionID,
+			State:           entry.state,
+			TransactionHash: entry.transactionHash,
+			client:          c,
+		}, nil
+	}
+
+	existing, err := c.findIdempotent(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		c.idempotency.store(key, existing)
+		return existing, nil
+	}
+
+	metadata = withIdempotencyKey(metadata, key)
+	var last *PreparedTransaction
+	resp, err := c.prepareAndSubmit(ctx, func(ctx context.Context) (*PreparedTransaction, error) {
+		prepared, err := prepare(ctx, metadata)
+		last = prepared
+		return prepared, err
+	})
+	if err != nil && resp == nil && last != nil && submitOutcomeUnknown(err) {
+		// The relayer may have accepted the request before failing and not list it yet.
+		// Resending the same signed body reuses its nonce, so a duplicate cannot execute;
+		// preparing again would sign a new nonce and relay the transaction twice.
+		existing, checkErr := c.findIdempotent(ctx, key)
+		switch {
+		case checkErr != nil:
+		case existing != nil:
+			resp, err = existing, nil
+		default:
+			c.log().Warn("submission with idempotency key %s failed and is not listed, resending the same request: %v", key, err)
+			resp, err = c.submitPrepared(ctx, last)
+		}
+	}
+	if resp != nil {
+		c.idempotency.store(key, resp)
+	}
+	return resp, err
+}
+
+// findIdempotent returns the non-failed relayer transaction carrying key, if any.
+func (c *RelayClient) findIdempotent(ctx context.Context, key string) (*ClientRelayerTransactionResponse, error) {
+	txns, err := c.GetTransactions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check idempotency key: %w", err)
+	}
+	for _, txn := range txns {
+		if hasIdempotencyKey(txn.Metadata, key) && !txn.State.IsFailure() {
+			return &ClientRelayerTransactionResponse{
+				TransactionID:   txn.TransactionID,
+				State:           string(txn.State),
+				TransactionHash: txn.TransactionHash,
+				client:          c,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// submitOutcomeUnknown reports whether a failed submission may still have been relayed:
+// a 5xx response or a transport error after the request was sent.
+func submitOutcomeUnknown(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+	var transportErr *transportError
+	return errors.As(err, &transportErr) && transportErr.sent &&
+		!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
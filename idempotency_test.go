@@ -0,0 +1,148 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func newIdempotencyTestClient(t *testing.T, existing string, submits *int, metadata *string) *RelayClient {
+	t.Helper()
+
+	client, _ := newFakeRelayer(t, map[string]http.HandlerFunc{
+		GetTransactionsEndpoint: respond(http.StatusOK, existing),
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			*submits++
+			*metadata = submittedRequest(r).Metadata
+			respond(http.StatusOK, `{"transactionID":"tx-new","state":"STATE_NEW"}`)(w, r)
+		},
+	})
+	return client
+}
+
+var idempotencyTestTxns = []types.Transaction{{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}}
+
+func TestExecute_IdempotencyKeySubmitsOnce(t *testing.T) {
+	t.Parallel()
+
+	var submits int
+	var metadata string
+	client := newIdempotencyTestClient(t, `[{"transactionID":"tx-old","state":"STATE_FAILED","metadata":"redeem idempotency-key:order-1"}]`, &submits, &metadata)
+
+	first, err := client.Execute(context.Background(), idempotencyTestTxns, "redeem", WithIdempotencyKey("order-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "tx-new", first.TransactionID)
+	assert.Equal(t, "redeem idempotency-key:order-1", metadata)
+
+	second, err := client.Execute(context.Background(), idempotencyTestTxns, "redeem", WithIdempotencyKey("order-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "tx-new", second.TransactionID)
+	assert.Equal(t, 1, submits)
+}
+
+func TestExecute_IdempotencyKeyReturnsRelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	var submits int
+	var metadata string
+	client := newIdempotencyTestClient(t, `[{"transactionID":"tx-old","state":"STATE_MINED","metadata":"idempotency-key:order-1"}]`, &submits, &metadata)
+
+	resp, err := client.Execute(context.Background(), idempotencyTestTxns, "", WithIdempotencyKey("order-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "tx-old", resp.TransactionID)
+	assert.Equal(t, string(types.StateMined), resp.State)
+	assert.Zero(t, submits)
+
+	_, err = client.Execute(context.Background(), idempotencyTestTxns, "", WithIdempotencyKey("order 2"))
+	require.ErrorIs(t, err, types.ErrInvalidIdempotencyKey)
+	_, err = client.Execute(context.Background(), idempotencyTestTxns, "", WithIdempotencyKey(""))
+	require.ErrorIs(t, err, types.ErrInvalidIdempotencyKey)
+}
+
+func TestExecute_IdempotencyKeyResendsSameRequestAfterServerError(t *testing.T) {
+	t.Parallel()
+
+	var bodies []string
+	var nonceLookups int
+	client, _ := newFakeRelayer(t, map[string]http.HandlerFunc{
+		GetNonceEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			nonceLookups++
+			respond(http.StatusOK, fmt.Sprintf(`{"nonce":"%d"}`, 2+nonceLookups))(w, r)
+		},
+		GetTransactionsEndpoint: respond(http.StatusOK, `[]`),
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if len(bodies) == 1 {
+				respond(http.StatusBadGateway, `{"error":"upstream timeout"}`)(w, r)
+				return
+			}
+			respond(http.StatusOK, `{"transactionID":"tx-new","state":"STATE_NEW"}`)(w, r)
+		},
+	})
+
+	resp, err := client.Execute(context.Background(), idempotencyTestTxns, "", WithIdempotencyKey("order-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "tx-new", resp.TransactionID)
+
+	require.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Equal(t, 1, nonceLookups)
+	var submitted types.TransactionRequest
+	require.NoError(t, json.Unmarshal([]byte(bodies[1]), &submitted))
+	assert.Equal(t, "3", submitted.Nonce)
+}
+
+func TestSubmitOutcomeUnknown_OnlyAfterRequestSent(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		transport roundTripFunc
+		unknown   bool
+	}{
+		"dial failure": {
+			transport: func(*http.Request) (*http.Response, error) {
+				return nil, errors.New("dial tcp: connection refused")
+			},
+		},
+		"connection reset after headers": {
+			transport: func(req *http.Request) (*http.Response, error) {
+				httptrace.ContextClientTrace(req.Context()).WroteHeaders()
+				return nil, errors.New("connection reset by peer")
+			},
+			unknown: true,
+		},
+		"server error": {
+			transport: func(*http.Request) (*http.Response, error) {
+				return newResponse(http.StatusBadGateway, `{"error":"upstream timeout"}`, nil), nil
+			},
+			unknown: true,
+		},
+		"client error": {
+			transport: func(*http.Request) (*http.Response, error) {
+				return newResponse(http.StatusBadRequest, `{"error":"bad request"}`, nil), nil
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := NewHTTPClient(&http.Client{Transport: tc.transport})
+			err := client.Do(context.Background(), http.MethodPost, "https://example.test"+SubmitTransactionEndpoint,
+				&RequestOptions{Body: []byte(`{}`), Retry: &RetryNever}, nil)
+			require.Error(t, err)
+			assert.Equal(t, tc.unknown, submitOutcomeUnknown(err))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const redactedHeaderValue = "[REDACTED]"
+
+// RequestInfo describes a single attempt of an outgoing request.
+type RequestInfo struct {
+	Method string
+	// Endpoint is the relayer endpoint constant (e.g. SubmitTransactionEndpoint) or the URL path.
+	Endpoint string
+	URL      string
+	// Attempt is the 1-based attempt number.
+	Attempt uint
+	// Headers is a copy of the request headers with builder credentials redacted.
+	Headers http.Header
+}
+
+// ResponseInfo describes the outcome of a single attempt.
+type ResponseInfo struct {
+	// StatusCode is zero when no response was received.
+	StatusCode int
+	Header     http.Header
+	Latency    time.Duration
+	// Err is set when the attempt failed before a complete response was read.
+	Err error
+}
+
+// Interceptor hooks into HTTPClient requests for tracing, metrics, auditing or custom headers.
+// Any hook may be nil. Hooks run synchronously on the calling goroutine, in registration order.
+type Interceptor struct {
+	// BeforeSend runs before every attempt. Headers added to extra are sent with the request.
+	// Returning an error aborts the request without sending it.
+	BeforeSend func(ctx context.Context, info RequestInfo, extra http.Header) error
+	// AfterReceive runs after every attempt, successful or not.
+	AfterReceive func(ctx context.Context, info RequestInfo, resp ResponseInfo)
+	// OnRetry runs before the client sleeps ahead of another attempt.
+	// info describes the failed attempt and reason is the error that caused the retry.
+	OnRetry func(ctx context.Context, info RequestInfo, delay time.Duration, reason error)
+}
+
+// WithInterceptors appends request interceptors to the HTTPClient.
+func WithInterceptors(interceptors ...Interceptor) HTTPClientOption {
+	return func(c *HTTPClient) { c.interceptors = append(c.interceptors, interceptors...) }
+}
+
+func beforeSend(ctx context.Context, interceptors []Interceptor, info RequestInfo, header http.Header) error {
+	for _, ic := range interceptors {
+		if ic.BeforeSend == nil {
+			continue
+		}
+		extra := http.Header{}
+		if err := ic.BeforeSend(ctx, info, extra); err != nil {
+			return fmt.Errorf("interceptor: %w", err)
+		}
+		for k, values := range extra {
+			for _, v := range values {
+				header.Add(k, v)
+			}
+		}
+	}
+	return nil
+}
+
+func afterReceive(ctx context.Context, interceptors []Interceptor, info RequestInfo, resp ResponseInfo) {
+	for _, ic := range interceptors {
+		if ic.AfterReceive != nil {
+			ic.AfterReceive(ctx, info, resp)
+		}
+	}
+}
+
+func onRetry(ctx context.Context, interceptors []Interceptor, info RequestInfo, delay time.Duration, reason error) {
+	for _, ic := range interceptors {
+		if ic.OnRetry != nil {
+			ic.OnRetry(ctx, info, delay, reason)
+		}
+	}
+}
+
+// redactHeaders returns a copy of h that is safe to log.
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	if out == nil {
+		return http.Header{}
+	}
+	if key := out.Get(HeaderPolyBuilderAPIKey); key != "" {
+		out.Set(HeaderPolyBuilderAPIKey, maskSecret(key))
+	}
+	for _, k := range []string{HeaderPolyBuilderPassphrase, HeaderPolyBuilderSignature, "Authorization"} {
+		if out.Get(k) != "" {
+			out.Set(k, redactedHeaderValue)
+		}
+	}
+	return out
+}
+
+func maskSecret(s string) string {
+	if len(s) <= 8 {
+		return "********"
+	}
+	return s[:4] + "..." + s[len(s)-4:]
+}
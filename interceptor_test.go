@@ -0,0 +1,90 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClientDo_InterceptorsObserveAttempts(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	var traceHeaders []string
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		traceHeaders = append(traceHeaders, req.Header.Get("X-Trace-Id"))
+		assert.Equal(t, "secret-pass", req.Header.Get(HeaderPolyBuilderPassphrase), "transport must see unredacted headers")
+		if attempts == 1 {
+			return newResponse(http.StatusServiceUnavailable, `{}`, nil), nil
+		}
+		return newResponse(http.StatusOK, `{}`, nil), nil
+	})}
+
+	var sent []RequestInfo
+	var received []ResponseInfo
+	var retries []time.Duration
+	client := NewHTTPClient(base, WithBaseDelay(time.Millisecond), WithInterceptors(Interceptor{
+		BeforeSend: func(_ context.Context, info RequestInfo, extra http.Header) error {
+			sent = append(sent, info)
+			extra.Set("X-Trace-Id", "trace-1")
+			return nil
+		},
+		AfterReceive: func(_ context.Context, _ RequestInfo, resp ResponseInfo) {
+			received = append(received, resp)
+		},
+		OnRetry: func(_ context.Context, info RequestInfo, delay time.Duration, reason error) {
+			assert.Equal(t, uint(1), info.Attempt)
+			assert.Error(t, reason)
+			retries = append(retries, delay)
+		},
+	}))
+
+	headers := http.Header{}
+	headers.Set(HeaderPolyBuilderAPIKey, "0123456789abcdef")
+	headers.Set(HeaderPolyBuilderPassphrase, "secret-pass")
+	headers.Set(HeaderPolyBuilderSignature, "signature")
+	err := client.Do(context.Background(), http.MethodPost, "https://relayer.test/submit", &RequestOptions{
+		Headers:  headers,
+		Endpoint: SubmitTransactionEndpoint,
+		Body:     []byte(`{}`),
+	}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2)
+	assert.Equal(t, SubmitTransactionEndpoint, sent[0].Endpoint)
+	assert.Equal(t, http.MethodPost, sent[0].Method)
+	assert.Equal(t, uint(2), sent[1].Attempt)
+	assert.Equal(t, "0123...cdef", sent[0].Headers.Get(HeaderPolyBuilderAPIKey))
+	assert.Equal(t, redactedHeaderValue, sent[0].Headers.Get(HeaderPolyBuilderPassphrase))
+	assert.Equal(t, redactedHeaderValue, sent[0].Headers.Get(HeaderPolyBuilderSignature))
+
+	require.Len(t, received, 2)
+	assert.Equal(t, http.StatusServiceUnavailable, received[0].StatusCode)
+	assert.Equal(t, http.StatusOK, received[1].StatusCode)
+	assert.Equal(t, []time.Duration{time.Millisecond}, retries)
+	assert.Equal(t, []string{"trace-1", "trace-1"}, traceHeaders)
+}
+
+func TestHTTPClientDo_InterceptorErrorAbortsRequest(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return newResponse(http.StatusOK, `{}`, nil), nil
+	})}
+	denied := errors.New("denied by audit policy")
+	client := NewHTTPClient(base, WithInterceptors(Interceptor{
+		BeforeSend: func(context.Context, RequestInfo, http.Header) error { return denied },
+	}))
+
+	err := client.Do(context.Background(), http.MethodGet, "https://relayer.test/nonce", nil, nil)
+	require.ErrorIs(t, err, denied)
+	assert.False(t, called)
+}
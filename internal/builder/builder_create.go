@@ -48,7 +48,7 @@ func BuildSafeCreateTransactionRequest(s signer.Signer, safeContractConfig types
 		return nil, fmt.Errorf("sign safe create: %w", err)
 	}
 
-	safeAddress, err := DeriveSafeAddress(args.From, safeFactory)
+	safeAddress, err := DeriveSafeAddressWithConfig(args.From, safeContractConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -11,30 +11,56 @@ import (
 )
 
 func DeriveProxyWalletAddress(eoa string, proxyFactory string) (string, error) {
-	if proxyFactory == "" {
+	return DeriveProxyWalletAddressWithConfig(eoa, types.ProxyContractConfig{ProxyFactory: proxyFactory})
+}
+
+// DeriveProxyWalletAddressWithConfig derives the proxy wallet address from the config's factory
+// and init code hash, falling back to types.ProxyInitCodeHash when the hash is empty.
+func DeriveProxyWalletAddressWithConfig(eoa string, config types.ProxyContractConfig) (string, error) {
+	if config.ProxyFactory == "" {
 		return "", types.ErrConfigUnsupported
 	}
-	addr := common.HexToAddress(eoa)
-	salt := crypto.Keccak256(addr.Bytes())
-	initCodeHash, err := hexutil.Decode(types.ProxyInitCodeHash)
+	initCodeHash, err := decodeInitCodeHash(config.InitCodeHash, types.ProxyInitCodeHash)
 	if err != nil {
 		return "", fmt.Errorf("invalid proxy init code hash: %w", err)
 	}
-	proxyAddr := crypto.CreateAddress2(common.HexToAddress(proxyFactory), common.BytesToHash(salt), initCodeHash)
+	addr := common.HexToAddress(eoa)
+	salt := crypto.Keccak256(addr.Bytes())
+	proxyAddr := crypto.CreateAddress2(common.HexToAddress(config.ProxyFactory), common.BytesToHash(salt), initCodeHash)
 	return proxyAddr.Hex(), nil
 }
 
 func DeriveSafeAddress(eoa string, safeFactory string) (string, error) {
-	if safeFactory == "" {
+	return DeriveSafeAddressWithConfig(eoa, types.SafeContractConfig{SafeFactory: safeFactory})
+}
+
+// DeriveSafeAddressWithConfig derives the Safe address from the config's factory and init
+// code hash, falling back to types.SafeInitCodeHash when the hash is empty.
+func DeriveSafeAddressWithConfig(eoa string, config types.SafeContractConfig) (string, error) {
+	if config.SafeFactory == "" {
 		return "", types.ErrConfigUnsupported
 	}
+	initCodeHash, err := decodeInitCodeHash(config.InitCodeHash, types.SafeInitCodeHash)
+	if err != nil {
+		return "", fmt.Errorf("invalid safe init code hash: %w", err)
+	}
 	addr := common.HexToAddress(eoa)
 	padded := common.LeftPadBytes(addr.Bytes(), 32)
 	salt := crypto.Keccak256(padded)
-	initCodeHash, err := hexutil.Decode(types.SafeInitCodeHash)
+	safeAddr := crypto.CreateAddress2(common.HexToAddress(config.SafeFactory), common.BytesToHash(salt), initCodeHash)
+	return safeAddr.Hex(), nil
+}
+
+func decodeInitCodeHash(hash, fallback string) ([]byte, error) {
+	if hash == "" {
+		hash = fallback
+	}
+	decoded, err := hexutil.Decode(hash)
 	if err != nil {
-		return "", fmt.Errorf("invalid safe init code hash: %w", err)
+		return nil, err
 	}
-	safeAddr := crypto.CreateAddress2(common.HexToAddress(safeFactory), common.BytesToHash(salt), initCodeHash)
-	return safeAddr.Hex(), nil
+	if len(decoded) != common.HashLength {
+		return nil, fmt.Errorf("want %d bytes, got %d", common.HashLength, len(decoded))
+	}
+	return decoded, nil
 }
@@ -77,10 +77,17 @@ func createProxyStructHash(
 }
 
 func BuildProxyTransactionRequest(ctx context.Context, s signer.Signer, args types.ProxyTransactionArgs, proxyContractConfig types.ProxyContractConfig, metadata string) (*types.TransactionRequest, error) {
+	request, _, err := BuildProxyTransactionRequestWithHash(ctx, s, args, proxyContractConfig, metadata)
+	return request, err
+}
+
+// BuildProxyTransactionRequestWithHash builds and signs a proxy transaction request and also
+// returns the proxy struct hash that was signed.
+func BuildProxyTransactionRequestWithHash(ctx context.Context, s signer.Signer, args types.ProxyTransactionArgs, proxyContractConfig types.ProxyContractConfig, metadata string) (*types.TransactionRequest, []byte, error) {
 	proxyFactory := proxyContractConfig.ProxyFactory
-	proxyWallet, err := DeriveProxyWalletAddress(args.From, proxyFactory)
+	proxyWallet, err := DeriveProxyWalletAddressWithConfig(args.From, proxyContractConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	gasLimitStr, err := getGasLimit(ctx, s, proxyFactory, args)
@@ -109,12 +116,12 @@ func BuildProxyTransactionRequest(ctx context.Context, s signer.Signer, args typ
 		args.Relay,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	sig, err := s.SignMessage(txHash)
 	if err != nil {
-		return nil, fmt.Errorf("sign proxy tx: %w", err)
+		return nil, nil, fmt.Errorf("sign proxy tx: %w", err)
 	}
 
 	return &types.TransactionRequest{
@@ -127,7 +134,7 @@ func BuildProxyTransactionRequest(ctx context.Context, s signer.Signer, args typ
 		Signature:       hexutil.Encode(sig),
 		SignatureParams: sigParams,
 		Metadata:        metadata,
-	}, nil
+	}, txHash, nil
 }
 
 func getGasLimit(ctx context.Context, s signer.Signer, to string, args types.ProxyTransactionArgs) (string, error) {
@@ -80,28 +80,35 @@ func createSafeStructHash(chainID int64, safeAddress string, txn types.SafeTrans
 }
 
 func BuildSafeTransactionRequest(s signer.Signer, args types.SafeTransactionArgs, safeContractConfig types.SafeContractConfig, metadata string) (*types.TransactionRequest, error) {
+	request, _, err := BuildSafeTransactionRequestWithHash(s, args, safeContractConfig, metadata)
+	return request, err
+}
+
+// BuildSafeTransactionRequestWithHash builds and signs a Safe transaction request and also
+// returns the Safe transaction hash that was signed.
+func BuildSafeTransactionRequestWithHash(s signer.Signer, args types.SafeTransactionArgs, safeContractConfig types.SafeContractConfig, metadata string) (*types.TransactionRequest, []byte, error) {
 	transaction, err := aggregateSafeTransactions(args.Transactions, safeContractConfig.SafeMultisend)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	safeAddress, err := DeriveSafeAddress(args.From, safeContractConfig.SafeFactory)
+	safeAddress, err := DeriveSafeAddressWithConfig(args.From, safeContractConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	structHash, err := createSafeStructHash(args.ChainID, safeAddress, transaction, args.Nonce)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	sig, err := s.SignMessage(structHash)
 	if err != nil {
-		return nil, fmt.Errorf("sign safe tx: %w", err)
+		return nil, nil, fmt.Errorf("sign safe tx: %w", err)
 	}
 
 	packedSig, err := utils.SplitAndPackSig(sig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	sigParams := types.SignatureParams{
@@ -123,5 +130,5 @@ func BuildSafeTransactionRequest(s signer.Signer, args types.SafeTransactionArgs
 		Signature:       packedSig,
 		SignatureParams: sigParams,
 		Metadata:        metadata,
-	}, nil
+	}, structHash, nil
 }
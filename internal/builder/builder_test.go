@@ -57,6 +57,32 @@ func TestDeriveProxyWalletAddress(t *testing.T) {
 	assert.True(t, common.IsHexAddress(addr))
 }
 
+func TestDeriveAddressWithConfig_InitCodeHash(t *testing.T) {
+	eoa := "0x1234567890123456789012345678901234567890"
+	factory := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+	// An empty hash falls back to the Polymarket constants.
+	addr, err := DeriveSafeAddressWithConfig(eoa, types.SafeContractConfig{SafeFactory: factory})
+	require.NoError(t, err)
+	legacy, err := DeriveSafeAddress(eoa, factory)
+	require.NoError(t, err)
+	assert.Equal(t, legacy, addr)
+
+	custom := "0x" + common.Bytes2Hex(crypto.Keccak256([]byte("local init code")))
+	safeAddr, err := DeriveSafeAddressWithConfig(eoa, types.SafeContractConfig{SafeFactory: factory, InitCodeHash: custom})
+	require.NoError(t, err)
+	assert.NotEqual(t, legacy, safeAddr)
+
+	proxyAddr, err := DeriveProxyWalletAddressWithConfig(eoa, types.ProxyContractConfig{ProxyFactory: factory, InitCodeHash: custom})
+	require.NoError(t, err)
+	legacyProxy, err := DeriveProxyWalletAddress(eoa, factory)
+	require.NoError(t, err)
+	assert.NotEqual(t, legacyProxy, proxyAddr)
+
+	_, err = DeriveSafeAddressWithConfig(eoa, types.SafeContractConfig{SafeFactory: factory, InitCodeHash: "0x1234"})
+	assert.Error(t, err)
+}
+
 func TestBuildSafeCreateTransactionRequest(t *testing.T) {
 	s := createTestSigner(t)
 
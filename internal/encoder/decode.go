@@ -0,0 +1,106 @@
+package encoder
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/utils"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// multisendEntryHeaderSize is operation (1) + to (20) + value (32) + data length (32).
+const multisendEntryHeaderSize = 1 + 20 + 32 + 32
+
+// IsSafeMultisendData reports whether data is a call to MultiSend.multiSend.
+func IsSafeMultisendData(data string) bool {
+	raw, err := utils.DecodeHex(data)
+	if err != nil || len(raw) < 4 {
+		return false
+	}
+	return bytes.Equal(raw[:4], multisendABI.Methods["multiSend"].ID)
+}
+
+// DecodeSafeMultisendData unpacks MultiSend.multiSend calldata into its inner transactions.
+func DecodeSafeMultisendData(data string) ([]types.SafeTransaction, error) {
+	raw, err := utils.DecodeHex(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+	method := multisendABI.Methods["multiSend"]
+	if len(raw) < 4 || !bytes.Equal(raw[:4], method.ID) {
+		return nil, fmt.Errorf("not a multiSend call")
+	}
+	args, err := method.Inputs.Unpack(raw[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack multisend: %w", err)
+	}
+	packed, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unpack multisend: unexpected argument type %T", args[0])
+	}
+	return decodePackedMultisend(packed)
+}
+
+func decodePackedMultisend(packed []byte) ([]types.SafeTransaction, error) {
+	var txns []types.SafeTransaction
+	for offset := 0; offset < len(packed); {
+		if len(packed)-offset < multisendEntryHeaderSize {
+			return nil, fmt.Errorf("truncated multisend entry at offset %d", offset)
+		}
+		operation := types.OperationType(packed[offset])
+		to := common.BytesToAddress(packed[offset+1 : offset+21])
+		value := new(big.Int).SetBytes(packed[offset+21 : offset+53])
+		dataLen := new(big.Int).SetBytes(packed[offset+53 : offset+85])
+		offset += multisendEntryHeaderSize
+
+		if !dataLen.IsInt64() || dataLen.Int64() > int64(len(packed)-offset) {
+			return nil, fmt.Errorf("truncated multisend data at offset %d", offset)
+		}
+		end := offset + int(dataLen.Int64())
+		txns = append(txns, types.SafeTransaction{
+			To:        to.Hex(),
+			Operation: operation,
+			Data:      hexutil.Encode(packed[offset:end]),
+			Value:     value.String(),
+		})
+		offset = end
+	}
+	return txns, nil
+}
+
+// DecodeProxyTransactionData unpacks ProxyFactory.proxy calldata into its inner calls.
+func DecodeProxyTransactionData(data string) ([]types.ProxyTransaction, error) {
+	raw, err := utils.DecodeHex(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+	method := proxyFactoryABI.Methods["proxy"]
+	if len(raw) < 4 || !bytes.Equal(raw[:4], method.ID) {
+		return nil, fmt.Errorf("not a proxy call")
+	}
+	args, err := method.Inputs.Unpack(raw[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack proxy data: %w", err)
+	}
+	calls := *abi.ConvertType(args[0], new([]proxyCall)).(*[]proxyCall)
+
+	txns := make([]types.ProxyTransaction, 0, len(calls))
+	for _, call := range calls {
+		value := "0"
+		if call.Value != nil {
+			value = call.Value.String()
+		}
+		txns = append(txns, types.ProxyTransaction{
+			To:       call.To.Hex(),
+			TypeCode: types.CallType(call.TypeCode),
+			Data:     hexutil.Encode(call.Data),
+			Value:    value,
+		})
+	}
+	return txns, nil
+}
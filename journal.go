@@ -0,0 +1,361 @@
+package relayer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// JournalEventType identifies what a JournalEntry records.
+type JournalEventType string
+
+const (
+	// JournalPrepared is recorded after a request is signed, before it is submitted.
+	JournalPrepared JournalEventType = "prepared"
+	// JournalSubmitted is recorded when the relayer accepted a request.
+	JournalSubmitted JournalEventType = "submitted"
+	// JournalSubmitFailed is recorded when a submission returned an error. OutcomeUnknown is
+	// set when the relayer may still have accepted it (a 5xx, or a transport error after the
+	// request was sent).
+	JournalSubmitFailed JournalEventType = "submit_failed"
+	// JournalState is recorded when a new transaction state is observed.
+	JournalState JournalEventType = "state"
+)
+
+// JournalEntry is a single journal record.
+type JournalEntry struct {
+	Type JournalEventType `json:"type"`
+	// Key identifies the prepared request: its signed hash, or the Keccak-256 of the
+	// request body for requests submitted without one (Submit, Deploy).
+	Key             string                        `json:"key,omitempty"`
+	TransactionID   string                        `json:"transactionID,omitempty"`
+	State           types.RelayerTransactionState `json:"state,omitempty"`
+	TransactionHash string                        `json:"transactionHash,omitempty"`
+	Request         *types.TransactionRequest     `json:"request,omitempty"`
+	Error           string                        `json:"error,omitempty"`
+	OutcomeUnknown  bool                          `json:"outcomeUnknown,omitempty"`
+	Time            time.Time                     `json:"time"`
+}
+
+// Journal persists the lifecycle of relayed transactions so they can be recovered after a restart.
+// Implementations must be safe for concurrent use.
+type Journal interface {
+	Append(ctx context.Context, entry JournalEntry) error
+	// Entries returns all entries in the order they were appended.
+	Entries(ctx context.Context) ([]JournalEntry, error)
+}
+
+// MemoryJournal is an in-process Journal, mainly useful for tests.
+type MemoryJournal struct {
+	mu      sync.Mutex
+	entries []JournalEntry
+}
+
+// NewMemoryJournal creates an empty MemoryJournal.
+func NewMemoryJournal() *MemoryJournal {
+	return &MemoryJournal{}
+}
+
+func (j *MemoryJournal) Append(_ context.Context, entry JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, entry)
+	return nil
+}
+
+func (j *MemoryJournal) Entries(context.Context) ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]JournalEntry(nil), j.entries...), nil
+}
+
+// FileJournal is a Journal stored as JSON lines in a single append-only file.
+type FileJournal struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// OpenFileJournal opens or creates the journal file at path.
+func OpenFileJournal(path string) (*FileJournal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	return &FileJournal{path: path, file: file}, nil
+}
+
+// Append writes entry as one line and syncs it to disk.
+func (j *FileJournal) Append(_ context.Context, entry JournalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encode journal entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.file.Write(line); err != nil {
+		return fmt.Errorf("write journal: %w", err)
+	}
+	if err := j.file.Sync(); err != nil {
+		return fmt.Errorf("sync journal: %w", err)
+	}
+	return nil
+}
+
+// Entries reads the journal file. A truncated last line, left by a crash mid-write, is ignored.
+func (j *FileJournal) Entries(context.Context) ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	file, err := os.Open(j.path)
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	defer file.Close()
+
+	var entries []JournalEntry
+	reader := bufio.NewReader(file)
+	for lineNo := 1; ; lineNo++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("read journal: %w", err)
+		}
+		complete := err == nil
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var entry JournalEntry
+			if decodeErr := json.Unmarshal(line, &entry); decodeErr != nil {
+				if !complete {
+					break
+				}
+				return nil, fmt.Errorf("decode journal line %d: %w", lineNo, decodeErr)
+			}
+			entries = append(entries, entry)
+		}
+		if !complete {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// Close closes the journal file.
+func (j *FileJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
+
+// PendingTransaction is a journaled transaction that has not reached a terminal state.
+type PendingTransaction struct {
+	Key string
+	// TransactionID is empty when the process stopped before the submission result was
+	// recorded, or the submission failed with an unknown outcome; the request may or may not
+	// have reached the relayer.
+	TransactionID string
+	State         types.RelayerTransactionState
+	Request       *types.TransactionRequest
+	UpdatedAt     time.Time
+}
+
+// PendingTransactions replays journal entries and returns the transactions that are
+// neither terminal nor definitely rejected at submission, in first-seen order.
+func PendingTransactions(entries []JournalEntry) []PendingTransaction {
+	type record struct {
+		PendingTransaction
+		done bool
+	}
+	var records []*record
+	byKey := map[string]*record{}
+	byID := map[string]*record{}
+	lookup := func(entry JournalEntry) *record {
+		if rec, ok := byID[entry.TransactionID]; ok && entry.TransactionID != "" {
+			return rec
+		}
+		if rec, ok := byKey[entry.Key]; ok && entry.Key != "" {
+			return rec
+		}
+		rec := &record{PendingTransaction: PendingTransaction{Key: entry.Key}}
+		records = append(records, rec)
+		if entry.Key != "" {
+			byKey[entry.Key] = rec
+		}
+		return rec
+	}
+
+	for _, entry := range entries {
+		rec := lookup(entry)
+		rec.UpdatedAt = entry.Time
+		if entry.Request != nil {
+			rec.Request = entry.Request
+		}
+		if entry.TransactionID != "" {
+			rec.TransactionID = entry.TransactionID
+			byID[entry.TransactionID] = rec
+		}
+		if entry.State != "" {
+			rec.State = entry.State
+		}
+		switch entry.Type {
+		case JournalSubmitFailed:
+			rec.done = !entry.OutcomeUnknown
+		case JournalSubmitted, JournalState:
+			rec.done = rec.State.IsTerminal()
+		}
+	}
+
+	var pending []PendingTransaction
+	for _, rec := range records {
+		if !rec.done {
+			pending = append(pending, rec.PendingTransaction)
+		}
+	}
+	return pending
+}
+
+// SetJournal records every prepared request, submission result and observed state change
+// to j. Pass nil to disable journaling. A failure to record a prepared request aborts the
+// submission; a failure to record the result is returned together with the response.
+func (c *RelayClient) SetJournal(j Journal) {
+	c.journal = j
+}
+
+// Recover reloads pending transactions from the journal and starts watching those that
+// have a transaction ID. Requests whose submission result is unknown are looked up in
+// GetTransactions by sender, target, data and nonce; a match is journaled and, unless it
+// is already terminal, watched. All remaining pending entries are returned, including
+// requests the relayer does not list, so the caller can reconcile them.
+func (c *RelayClient) Recover(ctx context.Context, w *Watcher) ([]PendingTransaction, error) {
+	if c.journal == nil {
+		return nil, errors.New("journal is not set")
+	}
+	entries, err := c.journal.Entries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := c.reconcilePending(ctx, PendingTransactions(entries))
+	if err != nil {
+		return nil, err
+	}
+	if w != nil {
+		for _, p := range pending {
+			w.Watch(p.TransactionID)
+		}
+	}
+	return pending, nil
+}
+
+// reconcilePending resolves pending requests without a transaction ID against the relayer's
+// transaction list and drops those that already reached a terminal state.
+func (c *RelayClient) reconcilePending(ctx context.Context, pending []PendingTransaction) ([]PendingTransaction, error) {
+	var txns []types.RelayerTransaction
+	fetched := false
+	var out []PendingTransaction
+	for _, p := range pending {
+		if p.TransactionID != "" || p.Request == nil {
+			out = append(out, p)
+			continue
+		}
+		if !fetched {
+			var err error
+			if txns, err = c.GetTransactions(ctx); err != nil {
+				return nil, fmt.Errorf("reconcile journal: %w", err)
+			}
+			fetched = true
+		}
+		idx := slices.IndexFunc(txns, func(txn types.RelayerTransaction) bool {
+			return matchesRequest(txn, p.Request)
+		})
+		if idx < 0 {
+			out = append(out, p)
+			continue
+		}
+		txn := txns[idx]
+		err := c.journalAppend(ctx, JournalEntry{
+			Type:            JournalSubmitted,
+			Key:             p.Key,
+			TransactionID:   txn.TransactionID,
+			State:           txn.State,
+			TransactionHash: txn.TransactionHash,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("record submission: %w", err)
+		}
+		if !txn.State.IsTerminal() {
+			p.TransactionID, p.State = txn.TransactionID, txn.State
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// matchesRequest reports whether txn is the relayed form of request.
+func matchesRequest(txn types.RelayerTransaction, request *types.TransactionRequest) bool {
+	return request.Nonce != "" && txn.Nonce == request.Nonce &&
+		strings.EqualFold(txn.From, request.From) &&
+		strings.EqualFold(txn.To, request.To) &&
+		strings.EqualFold(txn.Data, request.Data)
+}
+
+func (c *RelayClient) journalAppend(ctx context.Context, entry JournalEntry) error {
+	if c.journal == nil {
+		return nil
+	}
+	entry.Time = time.Now().UTC()
+	return c.journal.Append(ctx, entry)
+}
+
+// journalState records an observed state change.
+func (c *RelayClient) journalState(ctx context.Context, txn types.RelayerTransaction) error {
+	return c.journalAppend(ctx, JournalEntry{
+		Type:            JournalState,
+		TransactionID:   txn.TransactionID,
+		State:           txn.State,
+		TransactionHash: txn.TransactionHash,
+	})
+}
+
+// submitPrepared journals and submits a prepared request.
+func (c *RelayClient) submitPrepared(ctx context.Context, prepared *PreparedTransaction) (*ClientRelayerTransactionResponse, error) {
+	key := prepared.Hash
+	if key == "" {
+		key = crypto.Keccak256Hash(prepared.Body).Hex()
+	}
+	if err := c.journalAppend(ctx, JournalEntry{Type: JournalPrepared, Key: key, Request: prepared.Request}); err != nil {
+		return nil, fmt.Errorf("record prepared transaction: %w", err)
+	}
+
+	resp, err := c.submit(ctx, prepared.Body)
+	if err != nil {
+		failed := JournalEntry{Type: JournalSubmitFailed, Key: key, Error: err.Error(), OutcomeUnknown: submitOutcomeUnknown(err)}
+		if journalErr := c.journalAppend(ctx, failed); journalErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("record submit failure: %w", journalErr))
+		}
+		return nil, err
+	}
+	err = c.journalAppend(ctx, JournalEntry{
+		Type:            JournalSubmitted,
+		Key:             key,
+		TransactionID:   resp.TransactionID,
+		State:           types.RelayerTransactionState(resp.State),
+		TransactionHash: resp.TransactionHash,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("record submission: %w", err)
+	}
+	return resp, nil
+}
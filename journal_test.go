@@ -0,0 +1,147 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestFileJournal_RoundTripIgnoresTruncatedTail(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	j, err := OpenFileJournal(path)
+	require.NoError(t, err)
+	defer j.Close()
+
+	ctx := context.Background()
+	require.NoError(t, j.Append(ctx, JournalEntry{Type: JournalPrepared, Key: "0x01"}))
+	require.NoError(t, j.Append(ctx, JournalEntry{Type: JournalSubmitted, Key: "0x01", TransactionID: "tx-1", State: types.StateNew}))
+
+	// Simulate a crash in the middle of a write.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"type":"state","transac`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	entries, err := j.Entries(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "tx-1", entries[1].TransactionID)
+}
+
+func TestPendingTransactions(t *testing.T) {
+	t.Parallel()
+
+	entries := []JournalEntry{
+		{Type: JournalPrepared, Key: "0xa"},
+		{Type: JournalSubmitted, Key: "0xa", TransactionID: "tx-a", State: types.StateNew},
+		{Type: JournalPrepared, Key: "0xb"},
+		{Type: JournalSubmitFailed, Key: "0xb", Error: "bad request"},
+		{Type: JournalPrepared, Key: "0xc"},
+		{Type: JournalSubmitted, Key: "0xc", TransactionID: "tx-c", State: types.StateNew},
+		{Type: JournalState, TransactionID: "tx-c", State: types.StateConfirmed},
+		{Type: JournalPrepared, Key: "0xd"},
+		{Type: JournalState, TransactionID: "tx-a", State: types.StateMined},
+		{Type: JournalPrepared, Key: "0xe"},
+		{Type: JournalSubmitFailed, Key: "0xe", Error: "502 bad gateway", OutcomeUnknown: true},
+	}
+
+	pending := PendingTransactions(entries)
+	require.Len(t, pending, 3)
+	assert.Equal(t, "tx-a", pending[0].TransactionID)
+	assert.Equal(t, types.StateMined, pending[0].State)
+	assert.Equal(t, "0xd", pending[1].Key)
+	assert.Empty(t, pending[1].TransactionID)
+	assert.Equal(t, "0xe", pending[2].Key)
+}
+
+func TestRecover_ResumesWatchingJournaledTransactions(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newFakeRelayer(t, nil)
+	journal := NewMemoryJournal()
+	client.SetJournal(journal)
+
+	resp, err := client.Execute(context.Background(), []types.Transaction{{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}}, "")
+	require.NoError(t, err)
+
+	entries, err := journal.Entries(context.Background())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, JournalPrepared, entries[0].Type)
+	assert.Equal(t, "3", entries[0].Request.Nonce)
+	assert.Equal(t, JournalSubmitted, entries[1].Type)
+	assert.Equal(t, entries[0].Key, entries[1].Key)
+
+	// A new process reloads the journal.
+	restarted, _ := newFakeRelayer(t, nil)
+	restarted.SetJournal(journal)
+	w := restarted.NewWatcher(WatcherOptions{})
+	pending, err := restarted.Recover(context.Background(), w)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, resp.TransactionID, pending[0].TransactionID)
+	assert.Equal(t, 1, w.Len())
+}
+
+func TestRecover_ChecksUnknownSubmissionsAgainstRelayer(t *testing.T) {
+	t.Parallel()
+
+	var listed string
+	client, _ := newFakeRelayer(t, map[string]http.HandlerFunc{
+		SubmitTransactionEndpoint: respond(http.StatusServiceUnavailable, `{"error":"unavailable"}`),
+		GetTransactionsEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			respond(http.StatusOK, listed)(w, r)
+		},
+	})
+	journal := NewMemoryJournal()
+	client.SetJournal(journal)
+
+	_, err := client.Execute(context.Background(), []types.Transaction{{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}}, "")
+	require.Error(t, err)
+
+	entries, err := journal.Entries(context.Background())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, JournalSubmitFailed, entries[1].Type)
+	assert.True(t, entries[1].OutcomeUnknown)
+	request := entries[0].Request
+
+	listed = `[]`
+	pending, err := client.Recover(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Empty(t, pending[0].TransactionID)
+
+	relayed, err := json.Marshal([]types.RelayerTransaction{{
+		TransactionID: "tx-1",
+		From:          request.From,
+		To:            request.To,
+		Data:          request.Data,
+		Nonce:         request.Nonce,
+		State:         types.StateExecuted,
+	}})
+	require.NoError(t, err)
+	listed = string(relayed)
+	w := client.NewWatcher(WatcherOptions{})
+	pending, err = client.Recover(context.Background(), w)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "tx-1", pending[0].TransactionID)
+	assert.Equal(t, 1, w.Len())
+
+	entries, err = journal.Entries(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, PendingTransactions(entries), 1)
+	assert.Equal(t, "tx-1", PendingTransactions(entries)[0].TransactionID)
+}
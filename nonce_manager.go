@@ -0,0 +1,154 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// NonceManager hands out Safe nonces locally so that several transactions from the same
+// signer can be signed and submitted without waiting for the previous one to be mined.
+// Nonces are fetched from the relayer on first use and after Invalidate.
+// A NonceManager may be shared by several RelayClients; nonces are tracked per relayer URL,
+// chain, signer and wallet type.
+type NonceManager struct {
+	mu    sync.Mutex
+	slots map[string]*nonceSlot
+}
+
+type nonceSlot struct {
+	mu   sync.Mutex
+	next *big.Int
+}
+
+// NewNonceManager creates an empty NonceManager.
+func NewNonceManager() *NonceManager {
+	return &NonceManager{slots: make(map[string]*nonceSlot)}
+}
+
+// Reserve returns the next nonce for key and advances the local counter. When no nonce is
+// cached, fetch is called to load the current nonce; concurrent reservations for the same
+// key wait for it.
+func (m *NonceManager) Reserve(ctx context.Context, key string, fetch func(context.Context) (string, error)) (string, error) {
+	slot := m.slot(key)
+	slot.mu.Lock()
+	defer slot.mu.Unlock()
+
+	if err := slot.load(ctx, fetch); err != nil {
+		return "", err
+	}
+	nonce := slot.next.String()
+	slot.next = new(big.Int).Add(slot.next, big.NewInt(1))
+	return nonce, nil
+}
+
+// Peek returns the nonce the next Reserve for key would hand out, without advancing the
+// local counter.
+func (m *NonceManager) Peek(ctx context.Context, key string, fetch func(context.Context) (string, error)) (string, error) {
+	slot := m.slot(key)
+	slot.mu.Lock()
+	defer slot.mu.Unlock()
+
+	if err := slot.load(ctx, fetch); err != nil {
+		return "", err
+	}
+	return slot.next.String(), nil
+}
+
+// Observe records that nonce was used without a reservation, such as a prepared request
+// passed to Submit, so later reservations for key start after it.
+func (m *NonceManager) Observe(key, nonce string) {
+	used, ok := new(big.Int).SetString(strings.TrimSpace(nonce), 10)
+	if !ok || used.Sign() < 0 {
+		return
+	}
+	slot := m.slot(key)
+	slot.mu.Lock()
+	defer slot.mu.Unlock()
+	if slot.next != nil && slot.next.Cmp(used) <= 0 {
+		slot.next = used.Add(used, big.NewInt(1))
+	}
+}
+
+func (s *nonceSlot) load(ctx context.Context, fetch func(context.Context) (string, error)) error {
+	if s.next != nil {
+		return nil
+	}
+	raw, err := fetch(ctx)
+	if err != nil {
+		return err
+	}
+	next, ok := new(big.Int).SetString(strings.TrimSpace(raw), 10)
+	if !ok || next.Sign() < 0 {
+		return fmt.Errorf("%w: %q", types.ErrInvalidNoncePayload, raw)
+	}
+	s.next = next
+	return nil
+}
+
+// Invalidate drops the cached nonce for key so the next Reserve resyncs from the relayer.
+func (m *NonceManager) Invalidate(key string) {
+	slot := m.slot(key)
+	slot.mu.Lock()
+	slot.next = nil
+	slot.mu.Unlock()
+}
+
+func (m *NonceManager) slot(key string) *nonceSlot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	slot, ok := m.slots[key]
+	if !ok {
+		slot = &nonceSlot{}
+		m.slots[key] = slot
+	}
+	return slot
+}
+
+// nonceKey identifies a nonce sequence by relayer, chain, signer address and wallet type,
+// so clients for different relayers or chains sharing a NonceManager never share nonces.
+func nonceKey(relayerURL string, chainID int64, signerAddress string, walletType types.TransactionType) string {
+	return fmt.Sprintf("%s|%d|%s:%s", relayerURL, chainID, strings.ToLower(signerAddress), walletType)
+}
+
+// SetNonceManager enables local nonce reservation for Safe transactions. Pass nil to
+// fetch the nonce from the relayer on every transaction again.
+func (c *RelayClient) SetNonceManager(m *NonceManager) {
+	c.nonces = m
+}
+
+// prepareAndSubmit prepares and submits a transaction. When the nonce came from the
+// NonceManager, a failed submission resyncs the nonce, and a 4xx nonce conflict is retried
+// once with a freshly signed request. Other failures are never re-signed: after a 5xx or a
+// transport error the first request may still be relayed.
+func (c *RelayClient) prepareAndSubmit(ctx context.Context, prepare func(context.Context) (*PreparedTransaction, error)) (*ClientRelayerTransactionResponse, error) {
+	for attempt := 0; ; attempt++ {
+		prepared, err := prepare(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.submitPrepared(ctx, prepared)
+		if err == nil || resp != nil || prepared.nonceKey == "" || c.nonces == nil {
+			return resp, err
+		}
+		c.nonces.Invalidate(prepared.nonceKey)
+		if attempt > 0 || !nonceRejected(err) {
+			return nil, err
+		}
+		c.log().Warn("nonce %s rejected for %s, resyncing: %v", prepared.Request.Nonce, prepared.nonceKey, err)
+	}
+}
+
+// nonceRejected reports whether the relayer definitely refused a submission for its nonce.
+func nonceRejected(err error) bool {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode < 400 || httpErr.StatusCode >= 500 {
+		return false
+	}
+	return !submitOutcomeUnknown(err) && errors.Is(err, types.ErrNonceMismatch)
+}
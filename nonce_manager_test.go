@@ -0,0 +1,162 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestNonceManager_ReserveAndInvalidate(t *testing.T) {
+	t.Parallel()
+
+	m := NewNonceManager()
+	fetches := 0
+	fetch := func(context.Context) (string, error) {
+		fetches++
+		return "7", nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var got []string
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := m.Reserve(context.Background(), "a", fetch)
+			assert.NoError(t, err)
+			mu.Lock()
+			got = append(got, nonce)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	sort.Strings(got)
+	assert.Equal(t, []string{"10", "11", "7", "8", "9"}, got)
+	assert.Equal(t, 1, fetches)
+
+	m.Invalidate("a")
+	nonce, err := m.Reserve(context.Background(), "a", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "7", nonce)
+	assert.Equal(t, 2, fetches)
+
+	_, err = m.Reserve(context.Background(), "b", func(context.Context) (string, error) { return "", nil })
+	require.ErrorIs(t, err, types.ErrInvalidNoncePayload)
+}
+
+func TestExecute_NonceManagerPipelinesAndResyncsOnConflict(t *testing.T) {
+	t.Parallel()
+
+	var nonceFetches int
+	var submittedNonces []string
+	relayerNonce := "3"
+	client, _ := newFakeRelayer(t, map[string]http.HandlerFunc{
+		GetNonceEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			nonceFetches++
+			respond(http.StatusOK, `{"nonce":"`+relayerNonce+`"}`)(w, r)
+		},
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			nonce := submittedRequest(r).Nonce
+			submittedNonces = append(submittedNonces, nonce)
+			if nonce == "5" {
+				// Another process consumed nonce 5 behind our back.
+				relayerNonce = "6"
+				respond(http.StatusBadRequest, `{"error":"invalid nonce"}`)(w, r)
+				return
+			}
+			respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`)(w, r)
+		},
+	})
+	client.SetNonceManager(NewNonceManager())
+
+	tx := types.Transaction{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}
+	for i := 0; i < 3; i++ {
+		_, err := client.Execute(context.Background(), []types.Transaction{tx}, "")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"3", "4", "5", "6"}, submittedNonces)
+	assert.Equal(t, 2, nonceFetches)
+}
+
+func TestExecute_NonceManagerDoesNotResignAfterServerError(t *testing.T) {
+	t.Parallel()
+
+	client, fake := newFakeRelayer(t, map[string]http.HandlerFunc{
+		SubmitTransactionEndpoint: respond(http.StatusBadGateway, `{"error":"nonce too low","code":"NONCE_TOO_LOW"}`),
+	})
+	client.SetNonceManager(NewNonceManager())
+
+	_, err := client.Execute(context.Background(), []types.Transaction{{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}}, "")
+	require.ErrorIs(t, err, types.ErrNonceMismatch)
+	assert.Equal(t, 1, fake.Calls(SubmitTransactionEndpoint))
+	assert.Equal(t, 1, fake.Calls(GetNonceEndpoint))
+}
+
+func TestNonceManager_SharedAcrossChainsKeepsSeparateSequences(t *testing.T) {
+	t.Parallel()
+
+	var submittedNonces []string
+	polygon, fake := newFakeRelayer(t, map[string]http.HandlerFunc{
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			submittedNonces = append(submittedNonces, submittedRequest(r).Nonce)
+			respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`)(w, r)
+		},
+	})
+	amoy, err := NewRelayClient("https://example.test", 80002, newTestSigner(), testBuilderConfig(), types.RelayerTxSafe)
+	require.NoError(t, err)
+	amoy.SetHTTPClient(NewHTTPClient(&http.Client{Transport: fake}))
+
+	nonces := NewNonceManager()
+	polygon.SetNonceManager(nonces)
+	amoy.SetNonceManager(nonces)
+
+	tx := types.Transaction{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}
+	for _, client := range []*RelayClient{polygon, amoy, polygon} {
+		_, err := client.Execute(context.Background(), []types.Transaction{tx}, "")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"3", "3", "4"}, submittedNonces)
+	assert.Equal(t, 2, fake.Calls(GetNonceEndpoint))
+}
+
+func TestPrepare_NonceManagerDoesNotReserve(t *testing.T) {
+	t.Parallel()
+
+	var submittedNonces []string
+	client, fake := newFakeRelayer(t, map[string]http.HandlerFunc{
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			submittedNonces = append(submittedNonces, submittedRequest(r).Nonce)
+			respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`)(w, r)
+		},
+	})
+	client.SetNonceManager(NewNonceManager())
+	txns := []types.Transaction{{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}}
+
+	// A dry run is discarded; the next Execute still uses nonce 3.
+	discarded, err := client.Prepare(context.Background(), txns, "")
+	require.NoError(t, err)
+	assert.Equal(t, "3", discarded.Request.Nonce)
+	_, err = client.Execute(context.Background(), txns, "")
+	require.NoError(t, err)
+
+	// A prepared request relayed with Submit moves the sequence past its nonce.
+	prepared, err := client.Prepare(context.Background(), txns, "")
+	require.NoError(t, err)
+	_, err = client.Submit(context.Background(), prepared.Request)
+	require.NoError(t, err)
+	_, err = client.Execute(context.Background(), txns, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"3", "4", "5"}, submittedNonces)
+	assert.Equal(t, 1, fake.Calls(GetNonceEndpoint))
+}
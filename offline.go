@@ -0,0 +1,129 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/builder"
+	"github.com/GoPolymarket/go-builder-relayer-client/internal/encoder"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// OfflineSignParams holds everything SignTransactionRequest would otherwise fetch from the relayer.
+type OfflineSignParams struct {
+	ChainID int64
+	// TxType selects the wallet type. Defaults to types.RelayerTxSafe.
+	TxType types.RelayerTxType
+	// Nonce is the Safe nonce (GET /nonce) or the proxy relay nonce (GET /relay-payload).
+	Nonce string
+	// Relay is the relay address returned by GET /relay-payload. Required for PROXY.
+	Relay string
+	// GasLimit is the proxy gas limit. When empty the signer's gas estimator is consulted,
+	// falling back to a fixed default; set it to keep PROXY signing fully offline.
+	GasLimit string
+	Metadata string
+	// ContractConfig overrides the built-in contract config for ChainID.
+	ContractConfig *types.ContractConfig
+}
+
+// SignTransactionRequest builds and signs a transaction request without contacting the relayer.
+// The result can be archived, moved to another host and relayed with RelayClient.Submit.
+func SignTransactionRequest(ctx context.Context, s signer.Signer, txns []types.Transaction, params OfflineSignParams) (*PreparedTransaction, error) {
+	if s == nil {
+		return nil, types.ErrSignerUnavailable
+	}
+	if len(txns) == 0 {
+		return nil, types.ErrNoTransactions
+	}
+	if params.Nonce == "" {
+		return nil, types.ErrInvalidNoncePayload
+	}
+
+	var config types.ContractConfig
+	if params.ContractConfig != nil {
+		config = *params.ContractConfig
+	} else {
+		var err error
+		config, err = GetContractConfig(params.ChainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txType := params.TxType
+	if txType == "" {
+		txType = types.RelayerTxSafe
+	}
+	switch txType {
+	case types.RelayerTxSafe:
+		if !IsSafeContractConfigValid(config.SafeContracts) {
+			return nil, types.ErrConfigUnsupported
+		}
+		return signSafeTransactions(s, params.ChainID, config.SafeContracts, toSafeTransactions(txns), params.Nonce, params.Metadata)
+	case types.RelayerTxProxy:
+		if !IsProxyContractConfigValid(config.ProxyContracts) {
+			return nil, types.ErrConfigUnsupported
+		}
+		if params.Relay == "" {
+			return nil, fmt.Errorf("%w: relay address is required", types.ErrInvalidNoncePayload)
+		}
+		relay := types.RelayPayload{Address: params.Relay, Nonce: params.Nonce}
+		return signProxyTransactions(ctx, s, config.ProxyContracts, toProxyTransactions(txns), relay, params.GasLimit, params.Metadata)
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrUnsupportedTxType, txType)
+	}
+}
+
+// Submit relays a pre-signed transaction request. Only builder credentials are required;
+// the client does not need a signer. With a NonceManager set, a relayed Safe request's nonce
+// is marked as used.
+func (c *RelayClient) Submit(ctx context.Context, request *types.TransactionRequest) (*ClientRelayerTransactionResponse, error) {
+	if request == nil {
+		return nil, types.ErrNoTransactions
+	}
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+	resp, err := c.submitPrepared(ctx, &PreparedTransaction{Request: request, Body: payload})
+	if err == nil && c.nonces != nil && request.Type == string(types.TransactionTypeSafe) {
+		c.nonces.Observe(nonceKey(c.relayerURL, c.chainID, request.From, types.TransactionTypeSafe), request.Nonce)
+	}
+	return resp, err
+}
+
+func signSafeTransactions(s signer.Signer, chainID int64, config types.SafeContractConfig, txns []types.SafeTransaction, nonce string, metadata string) (*PreparedTransaction, error) {
+	args := types.SafeTransactionArgs{
+		From:         s.Address().Hex(),
+		Nonce:        nonce,
+		ChainID:      chainID,
+		Transactions: txns,
+	}
+	request, hash, err := builder.BuildSafeTransactionRequestWithHash(s, args, config, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return newPreparedTransaction(request, hash)
+}
+
+func signProxyTransactions(ctx context.Context, s signer.Signer, config types.ProxyContractConfig, txns []types.ProxyTransaction, relay types.RelayPayload, gasLimit string, metadata string) (*PreparedTransaction, error) {
+	data, err := encoder.EncodeProxyTransactionData(txns)
+	if err != nil {
+		return nil, err
+	}
+	args := types.ProxyTransactionArgs{
+		From:     s.Address().Hex(),
+		GasPrice: "0",
+		GasLimit: gasLimit,
+		Data:     data,
+		Relay:    relay.Address,
+		Nonce:    relay.Nonce,
+	}
+	request, hash, err := builder.BuildProxyTransactionRequestWithHash(ctx, s, args, config, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return newPreparedTransaction(request, hash)
+}
@@ -0,0 +1,67 @@
+package relayer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestSignTransactionRequest_OfflineThenSubmit(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	coldSigner, err := signer.NewPrivateKeySigner(common.Bytes2Hex(crypto.FromECDSA(key)), 137)
+	require.NoError(t, err)
+
+	txns := []types.Transaction{{To: "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", Data: "0x"}}
+	prepared, err := SignTransactionRequest(context.Background(), coldSigner, txns, OfflineSignParams{
+		ChainID:  137,
+		Nonce:    "12",
+		Metadata: "cold-signed",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, string(types.TransactionTypeSafe), prepared.Request.Type)
+	assert.Equal(t, "12", prepared.Request.Nonce)
+
+	var submitted []byte
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != SubmitTransactionEndpoint {
+			return newResponse(http.StatusNotFound, `{"error":"not found"}`, nil), nil
+		}
+		submitted, _ = io.ReadAll(req.Body)
+		assert.NotEmpty(t, req.Header.Get(HeaderPolyBuilderSignature))
+		return newResponse(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`, nil), nil
+	})
+
+	// The online host holds builder credentials only.
+	online, err := NewRelayClient("https://example.test", 137, nil, testBuilderConfig(), types.RelayerTxSafe)
+	require.NoError(t, err)
+	online.SetHTTPClient(NewHTTPClient(&http.Client{Transport: transport}))
+
+	resp, err := online.Submit(context.Background(), prepared.Request)
+	require.NoError(t, err)
+	assert.Equal(t, "tx-1", resp.TransactionID)
+	assert.JSONEq(t, string(prepared.Body), string(submitted))
+}
+
+func TestSignTransactionRequest_ProxyRequiresRelay(t *testing.T) {
+	t.Parallel()
+
+	s := &captureEstimateSigner{}
+	_, err := SignTransactionRequest(context.Background(), s, []types.Transaction{{To: types.ZeroAddress}}, OfflineSignParams{
+		ChainID: 137,
+		TxType:  types.RelayerTxProxy,
+		Nonce:   "1",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidNoncePayload)
+}
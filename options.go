@@ -0,0 +1,188 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/logger"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// RelayClientOption configures a RelayClient created by NewRelayClientWithOptions.
+type RelayClientOption func(*relayClientSettings)
+
+type relayClientSettings struct {
+	signer             signer.Signer
+	builderConfig      *BuilderConfig
+	relayTxType        types.RelayerTxType
+	httpClient         *HTTPClient
+	contractConfig     *types.ContractConfig
+	registry           *ContractRegistry
+	logger             logger.Logger
+	sleepFn            func(context.Context, time.Duration) error
+	waitOptions        WaitOptions
+	remoteSignerClient BuilderHTTPDoer
+	nonces             *NonceManager
+	journal            Journal
+	delegateCallPolicy DelegateCallPolicy
+	retryPolicies      map[string]*RetryPolicy
+	skewDisabled       bool
+}
+
+// WithSigner sets the signer used for Safe and proxy transactions.
+func WithSigner(s signer.Signer) RelayClientOption {
+	return func(o *relayClientSettings) { o.signer = s }
+}
+
+// WithBuilderConfig sets the builder attribution credentials.
+func WithBuilderConfig(config *BuilderConfig) RelayClientOption {
+	return func(o *relayClientSettings) { o.builderConfig = config }
+}
+
+// WithRelayerTxType selects Safe or proxy wallets. Defaults to types.RelayerTxSafe.
+func WithRelayerTxType(txType types.RelayerTxType) RelayClientOption {
+	return func(o *relayClientSettings) { o.relayTxType = txType }
+}
+
+// WithHTTPClient sets the HTTPClient used for relayer requests. Its own logger applies;
+// WithLogger only configures the default HTTPClient.
+func WithHTTPClient(client *HTTPClient) RelayClientOption {
+	return func(o *relayClientSettings) { o.httpClient = client }
+}
+
+// WithContractConfig uses config instead of looking the chain up in a ContractRegistry.
+func WithContractConfig(config types.ContractConfig) RelayClientOption {
+	return func(o *relayClientSettings) { o.contractConfig = &config }
+}
+
+// WithContractRegistry resolves the chain's contracts from registry instead of DefaultContractRegistry.
+func WithContractRegistry(registry *ContractRegistry) RelayClientOption {
+	return func(o *relayClientSettings) { o.registry = registry }
+}
+
+// WithLogger sets the logger of this client instead of logger.GetDefault().
+func WithLogger(l logger.Logger) RelayClientOption {
+	return func(o *relayClientSettings) { o.logger = l }
+}
+
+// WithSleeper replaces the function used to wait between transaction polls, e.g. to
+// make polling instantaneous in tests. It must return ctx.Err() when ctx is done.
+func WithSleeper(sleep func(ctx context.Context, d time.Duration) error) RelayClientOption {
+	return func(o *relayClientSettings) { o.sleepFn = sleep }
+}
+
+// WithWaitOptions sets the defaults for Wait, WaitUntil and the zero fields of WaitWithOptions.
+func WithWaitOptions(opts WaitOptions) RelayClientOption {
+	return func(o *relayClientSettings) { o.waitOptions = opts }
+}
+
+// WithRemoteSignerHTTPClient sets the HTTP client used to call remote builder signers that do
+// not set their own, including those wrapped in BuilderConfig.Signer by FallbackBuilderSigner,
+// RoundRobinBuilderSigner or CachingBuilderSigner.
+func WithRemoteSignerHTTPClient(client BuilderHTTPDoer) RelayClientOption {
+	return func(o *relayClientSettings) { o.remoteSignerClient = client }
+}
+
+// WithNonceManager enables local Safe nonce reservation; see SetNonceManager.
+func WithNonceManager(m *NonceManager) RelayClientOption {
+	return func(o *relayClientSettings) { o.nonces = m }
+}
+
+// WithJournal records transaction lifecycles to j; see SetJournal.
+func WithJournal(j Journal) RelayClientOption {
+	return func(o *relayClientSettings) { o.journal = j }
+}
+
+// WithDelegateCallPolicy sets the delegate call allowlist; see SetDelegateCallPolicy.
+func WithDelegateCallPolicy(policy DelegateCallPolicy) RelayClientOption {
+	return func(o *relayClientSettings) { o.delegateCallPolicy = policy }
+}
+
+// WithRetryPolicy sets the retry policy of an endpoint; see SetRetryPolicy.
+func WithRetryPolicy(endpoint string, policy *RetryPolicy) RelayClientOption {
+	return func(o *relayClientSettings) {
+		if o.retryPolicies == nil {
+			o.retryPolicies = make(map[string]*RetryPolicy)
+		}
+		o.retryPolicies[endpoint] = policy
+	}
+}
+
+// WithClockSkewCompensation controls whether builder timestamps are shifted by the clock
+// skew measured from relayer responses; see RelayClient.ClockSkew. Enabled by default.
+func WithClockSkewCompensation(enabled bool) RelayClientOption {
+	return func(o *relayClientSettings) { o.skewDisabled = !enabled }
+}
+
+// NewRelayClientWithOptions creates a RelayClient for chainID configured by opts. Without
+// options it is equivalent to NewRelayClient with a nil signer and builder config.
+func NewRelayClientWithOptions(relayerURL string, chainID int64, opts ...RelayClientOption) (*RelayClient, error) {
+	var settings relayClientSettings
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	var config types.ContractConfig
+	if settings.contractConfig != nil {
+		if err := validateContractConfig(*settings.contractConfig); err != nil {
+			return nil, err
+		}
+		config = *settings.contractConfig
+	} else {
+		registry := settings.registry
+		if registry == nil {
+			registry = DefaultContractRegistry
+		}
+		var err error
+		if config, err = registry.Get(chainID); err != nil {
+			return nil, err
+		}
+	}
+
+	relayTxType := settings.relayTxType
+	if relayTxType == "" {
+		relayTxType = types.RelayerTxSafe
+	}
+	httpClient := settings.httpClient
+	if httpClient == nil {
+		httpClient = NewHTTPClient(nil, WithHTTPLogger(settings.logger))
+	}
+	sleepFn := settings.sleepFn
+	if sleepFn == nil {
+		sleepFn = sleepWithContext
+	}
+	c := &RelayClient{
+		relayerURL:         strings.TrimRight(relayerURL, "/"),
+		chainID:            chainID,
+		relayTxType:        relayTxType,
+		contractConfig:     config,
+		httpClient:         httpClient,
+		signer:             settings.signer,
+		builderConfig:      settings.builderConfig,
+		remoteSignerClient: settings.remoteSignerClient,
+		sleepFn:            sleepFn,
+		logger:             settings.logger,
+		waitOptions:        settings.waitOptions,
+		delegateCallPolicy: settings.delegateCallPolicy,
+		nonces:             settings.nonces,
+		journal:            settings.journal,
+		idempotency:        newIdempotencyRecord(),
+		retryPolicies:      defaultRetryPolicies(),
+		skewDisabled:       settings.skewDisabled,
+	}
+	for endpoint, policy := range settings.retryPolicies {
+		c.SetRetryPolicy(endpoint, policy)
+	}
+	return c, nil
+}
+
+func (c *RelayClient) log() logger.Logger {
+	if c.logger != nil {
+		return c.logger
+	}
+	return logger.GetDefault()
+}
@@ -0,0 +1,180 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/logger"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+type recordingLogger struct {
+	logger.NoOpLogger
+	mu       sync.Mutex
+	warnings []string
+}
+
+func (l *recordingLogger) Warn(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warnings = append(l.warnings, fmt.Sprintf(format, args...))
+}
+
+func TestNewRelayClientWithOptions_Defaults(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewRelayClientWithOptions("https://example.test/", 137)
+	require.NoError(t, err)
+
+	config, err := GetContractConfig(137)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.test", client.relayerURL)
+	assert.Equal(t, types.RelayerTxSafe, client.relayTxType)
+	assert.Equal(t, config, client.contractConfig)
+	assert.NotNil(t, client.httpClient)
+	assert.Equal(t, RetryUnsent, *client.retryPolicy(context.Background(), SubmitTransactionEndpoint))
+
+	_, err = NewRelayClientWithOptions("https://example.test", 31337)
+	assert.ErrorIs(t, err, types.ErrConfigUnsupported)
+}
+
+func TestNewRelayClientWithOptions_ContractConfig(t *testing.T) {
+	t.Parallel()
+
+	config, err := GetContractConfig(137)
+	require.NoError(t, err)
+
+	client, err := NewRelayClientWithOptions("https://example.test", 31337,
+		WithContractConfig(config),
+		WithRelayerTxType(types.RelayerTxProxy),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, config, client.contractConfig)
+	assert.Equal(t, types.RelayerTxProxy, client.relayTxType)
+
+	config.SafeContracts.SafeFactory = "not-an-address"
+	_, err = NewRelayClientWithOptions("https://example.test", 31337, WithContractConfig(config))
+	assert.Error(t, err)
+}
+
+func TestNewRelayClientWithOptions_SleeperAndWaitOptions(t *testing.T) {
+	t.Parallel()
+
+	var polls int
+	var delays []time.Duration
+	client, err := NewRelayClientWithOptions("https://example.test", 137,
+		WithBuilderConfig(testBuilderConfig()),
+		WithHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			polls++
+			return newResponse(http.StatusOK, `[{"transactionID":"tx-1","state":"STATE_NEW"}]`, nil), nil
+		})})),
+		WithSleeper(func(_ context.Context, d time.Duration) error {
+			delays = append(delays, d)
+			return nil
+		}),
+		WithWaitOptions(WaitOptions{MaxPolls: 3, PollFrequency: 3 * time.Second}),
+	)
+	require.NoError(t, err)
+
+	resp := &ClientRelayerTransactionResponse{TransactionID: "tx-1", client: client}
+	_, err = resp.Wait(context.Background())
+	assert.ErrorIs(t, err, types.ErrTransactionTimeout)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, []time.Duration{3 * time.Second, 3 * time.Second}, delays)
+
+	// Explicit fields override the client defaults.
+	polls, delays = 0, nil
+	_, err = resp.WaitWithOptions(context.Background(), WaitOptions{MaxPolls: 2})
+	assert.ErrorIs(t, err, types.ErrTransactionTimeout)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, []time.Duration{3 * time.Second}, delays)
+}
+
+func TestNewRelayClientWithOptions_RemoteSignerHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	remote := &BuilderRemoteConfig{Host: "https://remote-signer.test/sign"}
+	configs := map[string]*BuilderConfig{
+		"remote config": {Remote: remote},
+		"wrapped signer": {Signer: NewCachingBuilderSigner(
+			NewRoundRobinBuilderSigner(FallbackBuilderSigner{remote}), time.Minute)},
+	}
+	for name, builderConfig := range configs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var signerCalls int
+			remoteClient := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				signerCalls++
+				assert.Equal(t, "remote-signer.test", req.URL.Host)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`{
+						"POLY_BUILDER_API_KEY":"remote-key",
+						"POLY_BUILDER_PASSPHRASE":"remote-pass",
+						"POLY_BUILDER_SIGNATURE":"remote-sig",
+						"POLY_BUILDER_TIMESTAMP":"1730000000000"
+					}`)),
+				}, nil
+			})
+
+			client, err := NewRelayClientWithOptions("https://example.test", 137,
+				WithBuilderConfig(builderConfig),
+				WithRemoteSignerHTTPClient(&http.Client{Transport: remoteClient}),
+				WithHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, "remote-key", req.Header.Get(HeaderPolyBuilderAPIKey))
+					return newResponse(http.StatusOK, `[]`, nil), nil
+				})})),
+			)
+			require.NoError(t, err)
+
+			_, err = client.GetTransactions(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 1, signerCalls)
+			assert.Nil(t, remote.HTTPClient, "caller's config must not be modified")
+		})
+	}
+}
+
+func TestNewRelayClientWithOptions_Logger(t *testing.T) {
+	t.Parallel()
+
+	log := &recordingLogger{}
+	client, err := NewRelayClientWithOptions("https://example.test", 137, WithLogger(log))
+	require.NoError(t, err)
+	assert.Same(t, log, client.log())
+	assert.Same(t, log, client.httpClient.log())
+
+	client, err = NewRelayClientWithOptions("https://example.test", 137)
+	require.NoError(t, err)
+	assert.Equal(t, logger.GetDefault(), client.log())
+}
+
+func TestHTTPClient_WithHTTPLogger(t *testing.T) {
+	t.Parallel()
+
+	log := &recordingLogger{}
+	attempts := 0
+	client := NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return newResponse(http.StatusOK, `{}`, nil), nil
+	})}, WithHTTPLogger(log), WithBaseDelay(time.Millisecond))
+
+	var out map[string]any
+	require.NoError(t, client.Do(context.Background(), http.MethodGet, "https://example.test/nonce", nil, &out))
+	require.Len(t, log.warnings, 1)
+	assert.Contains(t, log.warnings[0], "connection reset")
+}
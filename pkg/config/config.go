@@ -0,0 +1,469 @@
+// Package config loads a RelayClient configuration from environment variables and from
+// JSON or YAML files with named profiles, validates it and constructs the client.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	relayer "github.com/GoPolymarket/go-builder-relayer-client"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/signer"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// Environment variables read by FromEnv and Load. Every secret variable also has a
+// "_FILE" variant, e.g. PRIVATE_KEY_FILE, naming a file that holds the value.
+const (
+	EnvConfigFile          = "POLYMARKET_RELAYER_CONFIG"
+	EnvProfile             = "POLYMARKET_RELAYER_PROFILE"
+	EnvRelayerURL          = "POLYMARKET_RELAYER_URL"
+	EnvChainID             = "CHAIN_ID"
+	EnvPrivateKey          = "PRIVATE_KEY"
+	EnvTxType              = "RELAYER_TX_TYPE"
+	EnvBuilderAPIKey       = "BUILDER_API_KEY"
+	EnvBuilderSecret       = "BUILDER_SECRET"
+	EnvBuilderPassphrase   = "BUILDER_PASS_PHRASE"
+	EnvBuilderRemoteHost   = "BUILDER_REMOTE_HOST"
+	EnvBuilderRemoteToken  = "BUILDER_REMOTE_TOKEN"
+	EnvRemoteFallbackLocal = "BUILDER_REMOTE_FALLBACK_LOCAL"
+
+	fileEnvSuffix = "_FILE"
+)
+
+// Config is a complete RelayClient configuration.
+type Config struct {
+	RelayerURL string `json:"relayerUrl,omitempty" yaml:"relayerUrl,omitempty"`
+	ChainID    int64  `json:"chainId,omitempty" yaml:"chainId,omitempty"`
+	// PrivateKey is the hex key of the signer. It is optional for read-only clients.
+	PrivateKey Secret `json:"privateKey,omitempty" yaml:"privateKey,omitempty"`
+	// TxType is SAFE or PROXY. Defaults to SAFE.
+	TxType  types.RelayerTxType `json:"txType,omitempty" yaml:"txType,omitempty"`
+	Builder Builder             `json:"builder,omitempty" yaml:"builder,omitempty"`
+}
+
+// Builder holds the builder attribution credentials: local API credentials, a remote
+// signer, or both with RemoteFallbackLocal. With a remote host and no fallback, local
+// credentials are ignored.
+type Builder struct {
+	APIKey      Secret `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+	Secret      Secret `json:"secret,omitempty" yaml:"secret,omitempty"`
+	Passphrase  Secret `json:"passphrase,omitempty" yaml:"passphrase,omitempty"`
+	RemoteHost  string `json:"remoteHost,omitempty" yaml:"remoteHost,omitempty"`
+	RemoteToken Secret `json:"remoteToken,omitempty" yaml:"remoteToken,omitempty"`
+	// RemoteFallbackLocal signs locally when the remote signer fails. A profile or the
+	// environment can enable it but only the environment can disable it again.
+	RemoteFallbackLocal bool `json:"remoteFallbackLocal,omitempty" yaml:"remoteFallbackLocal,omitempty"`
+}
+
+// Secret is a value given inline or read from File. In JSON and YAML it is either a
+// string or an object {"file": "/run/secrets/name"}; relative paths are resolved against
+// the directory of the configuration file.
+type Secret struct {
+	Value string
+	File  string
+}
+
+// String redacts the secret so that configurations can be logged.
+func (s Secret) String() string {
+	if s.IsZero() {
+		return ""
+	}
+	return "[redacted]"
+}
+
+// IsZero reports whether neither a value nor a file is set.
+func (s Secret) IsZero() bool {
+	return s.Value == "" && s.File == ""
+}
+
+// resolve reads the secret file into Value. Surrounding whitespace, such as the trailing
+// newline of most secret files, is removed.
+func (s *Secret) resolve(name string) error {
+	if s.File == "" {
+		return nil
+	}
+	if s.Value != "" {
+		return fmt.Errorf("%w: both %s and its file are set", types.ErrInvalidConfig, name)
+	}
+	data, err := os.ReadFile(s.File)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", name, err)
+	}
+	s.Value, s.File = strings.TrimSpace(string(data)), ""
+	return nil
+}
+
+// UnmarshalJSON accepts a string or {"file": "..."}.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var ref struct {
+			File string `json:"file"`
+		}
+		if err := json.Unmarshal(data, &ref); err != nil {
+			return err
+		}
+		*s = Secret{File: ref.File}
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = Secret{Value: value}
+	return nil
+}
+
+// MarshalJSON writes the file reference, or the value when the secret is inline.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	if s.File != "" {
+		return json.Marshal(map[string]string{"file": s.File})
+	}
+	return json.Marshal(s.Value)
+}
+
+// UnmarshalYAML accepts a scalar or a mapping with a file key.
+func (s *Secret) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.MappingNode {
+		var ref struct {
+			File string `yaml:"file"`
+		}
+		if err := node.Decode(&ref); err != nil {
+			return err
+		}
+		*s = Secret{File: ref.File}
+		return nil
+	}
+	var value string
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	*s = Secret{Value: value}
+	return nil
+}
+
+// fileConfig is the layout of a configuration file: shared settings at the top level and
+// per-environment overrides under profiles.
+type fileConfig struct {
+	Config         `yaml:",inline"`
+	DefaultProfile string            `json:"profile,omitempty" yaml:"profile,omitempty"`
+	Profiles       map[string]Config `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+}
+
+// Load builds the configuration from the file named by POLYMARKET_RELAYER_CONFIG, if set,
+// using the profile named by POLYMARKET_RELAYER_PROFILE, then applies the environment
+// variables on top. The result is validated, except for the chain, which NewRelayClient
+// looks up in the contract registry the client uses.
+func Load() (*Config, error) {
+	return load(os.LookupEnv)
+}
+
+func load(lookup func(string) (string, bool)) (*Config, error) {
+	cfg := &Config{}
+	if path, ok := lookup(EnvConfigFile); ok && path != "" {
+		profile, _ := lookup(EnvProfile)
+		fileCfg, err := LoadFile(path, profile)
+		if err != nil {
+			return nil, err
+		}
+		cfg = fileCfg
+	}
+	if err := cfg.applyEnv(lookup); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(nil); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// FromEnv reads the configuration from environment variables only. It is not validated.
+func FromEnv() (*Config, error) {
+	cfg := &Config{}
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadFile reads a JSON (.json) or YAML (.yaml, .yml) configuration file and returns its
+// top-level settings overridden by the given profile. An empty profile selects the file's
+// "profile" key, or the top-level settings alone when that is unset. It is not validated.
+//
+//	profile: prod
+//	builder:
+//	  apiKey: {file: secrets/builder-key}
+//	profiles:
+//	  prod:
+//	    relayerUrl: https://relayer-v2.polymarket.com/
+//	    chainId: 137
+func LoadFile(path, profile string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	var file fileConfig
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&file)
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err = dec.Decode(&file); errors.Is(err, io.EOF) {
+			err = nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported config file extension %q", types.ErrInvalidConfig, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode config %s: %w", path, err)
+	}
+
+	cfg := file.Config
+	if profile == "" {
+		profile = file.DefaultProfile
+	}
+	if profile != "" {
+		override, ok := file.Profiles[profile]
+		if !ok {
+			names := make([]string, 0, len(file.Profiles))
+			for name := range file.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("%w: unknown profile %q in %s (available: %s)", types.ErrInvalidConfig, profile, path, strings.Join(names, ", "))
+		}
+		cfg.merge(override)
+	}
+
+	dir := filepath.Dir(path)
+	for _, s := range cfg.secrets() {
+		if s.secret.File != "" && !filepath.IsAbs(s.secret.File) {
+			s.secret.File = filepath.Join(dir, s.secret.File)
+		}
+	}
+	return &cfg, nil
+}
+
+// merge overrides the fields of c that are set in o.
+func (c *Config) merge(o Config) {
+	setString(&c.RelayerURL, o.RelayerURL)
+	if o.ChainID != 0 {
+		c.ChainID = o.ChainID
+	}
+	setSecret(&c.PrivateKey, o.PrivateKey)
+	if o.TxType != "" {
+		c.TxType = o.TxType
+	}
+	setSecret(&c.Builder.APIKey, o.Builder.APIKey)
+	setSecret(&c.Builder.Secret, o.Builder.Secret)
+	setSecret(&c.Builder.Passphrase, o.Builder.Passphrase)
+	setString(&c.Builder.RemoteHost, o.Builder.RemoteHost)
+	setSecret(&c.Builder.RemoteToken, o.Builder.RemoteToken)
+	c.Builder.RemoteFallbackLocal = c.Builder.RemoteFallbackLocal || o.Builder.RemoteFallbackLocal
+}
+
+func setString(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+// setSecret replaces dst entirely, so that an inline value overrides a file and vice versa.
+func setSecret(dst *Secret, value Secret) {
+	if !value.IsZero() {
+		*dst = value
+	}
+}
+
+// applyEnv overrides c with the environment variables that are set.
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	env := func(name string) string {
+		value, _ := lookup(name)
+		return strings.TrimSpace(value)
+	}
+	secret := func(name string) (Secret, error) {
+		value, file := env(name), env(name+fileEnvSuffix)
+		if value != "" && file != "" {
+			return Secret{}, fmt.Errorf("%w: both %s and %s%s are set", types.ErrInvalidConfig, name, name, fileEnvSuffix)
+		}
+		return Secret{Value: value, File: file}, nil
+	}
+
+	var o Config
+	o.RelayerURL = env(EnvRelayerURL)
+	if raw := env(EnvChainID); raw != "" {
+		chainID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", types.ErrInvalidConfig, EnvChainID, err)
+		}
+		o.ChainID = chainID
+	}
+	o.TxType = types.RelayerTxType(strings.ToUpper(env(EnvTxType)))
+	o.Builder.RemoteHost = env(EnvBuilderRemoteHost)
+
+	secrets := []struct {
+		name string
+		dst  *Secret
+	}{
+		{EnvPrivateKey, &o.PrivateKey},
+		{EnvBuilderAPIKey, &o.Builder.APIKey},
+		{EnvBuilderSecret, &o.Builder.Secret},
+		{EnvBuilderPassphrase, &o.Builder.Passphrase},
+		{EnvBuilderRemoteToken, &o.Builder.RemoteToken},
+	}
+	for _, s := range secrets {
+		value, err := secret(s.name)
+		if err != nil {
+			return err
+		}
+		*s.dst = value
+	}
+	c.merge(o)
+
+	if raw := env(EnvRemoteFallbackLocal); raw != "" {
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", types.ErrInvalidConfig, EnvRemoteFallbackLocal, err)
+		}
+		c.Builder.RemoteFallbackLocal = enabled
+	}
+	return nil
+}
+
+type namedSecret struct {
+	name   string
+	secret *Secret
+}
+
+func (c *Config) secrets() []namedSecret {
+	return []namedSecret{
+		{"privateKey", &c.PrivateKey},
+		{"builder.apiKey", &c.Builder.APIKey},
+		{"builder.secret", &c.Builder.Secret},
+		{"builder.passphrase", &c.Builder.Passphrase},
+		{"builder.remoteToken", &c.Builder.RemoteToken},
+	}
+}
+
+// Validate reads file-backed secrets into their values and checks that the relayer URL is
+// an absolute http(s) URL, the chain is in relayer.DefaultContractRegistry, the transaction
+// type and private key are valid, and the builder credentials are complete.
+func (c *Config) Validate() error {
+	return c.ValidateWithRegistry(relayer.DefaultContractRegistry)
+}
+
+// ValidateWithRegistry is Validate for clients created with relayer.WithContractRegistry:
+// the chain must be in registry instead of relayer.DefaultContractRegistry.
+func (c *Config) ValidateWithRegistry(registry *relayer.ContractRegistry) error {
+	if registry == nil {
+		registry = relayer.DefaultContractRegistry
+	}
+	return c.validate(registry)
+}
+
+// validate checks the configuration; a nil registry skips the chain lookup.
+func (c *Config) validate(registry *relayer.ContractRegistry) error {
+	for _, s := range c.secrets() {
+		if err := s.secret.resolve(s.name); err != nil {
+			return err
+		}
+	}
+
+	if c.RelayerURL == "" {
+		return fmt.Errorf("%w: relayer URL is required", types.ErrInvalidConfig)
+	}
+	u, err := url.Parse(c.RelayerURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: relayer URL must be an absolute http(s) URL: %q", types.ErrInvalidConfig, c.RelayerURL)
+	}
+	if c.ChainID == 0 {
+		return fmt.Errorf("%w: chain ID is required", types.ErrInvalidConfig)
+	}
+	if registry != nil {
+		if _, err := registry.Get(c.ChainID); err != nil {
+			return fmt.Errorf("%w: %w", types.ErrInvalidConfig, err)
+		}
+	}
+	if c.TxType != "" && c.TxType != types.RelayerTxSafe && c.TxType != types.RelayerTxProxy {
+		return fmt.Errorf("%w: transaction type must be %s or %s: %q", types.ErrInvalidConfig, types.RelayerTxSafe, types.RelayerTxProxy, c.TxType)
+	}
+	if c.PrivateKey.Value != "" {
+		if _, err := signer.NewPrivateKeySigner(c.PrivateKey.Value, c.ChainID); err != nil {
+			return fmt.Errorf("%w: %w", types.ErrInvalidConfig, err)
+		}
+	}
+	if c.Builder.RemoteHost != "" {
+		if u, err := url.Parse(c.Builder.RemoteHost); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: builder remote host must be an absolute http(s) URL: %q", types.ErrInvalidConfig, c.Builder.RemoteHost)
+		}
+	}
+	if !c.BuilderConfig().IsValid() {
+		return fmt.Errorf("%w: builder authentication requires a remote host or an API key, secret and passphrase", types.ErrInvalidConfig)
+	}
+	return nil
+}
+
+// BuilderConfig returns the relayer builder configuration. When a remote host is set, the
+// local credentials are only used as its fallback and are ignored without
+// RemoteFallbackLocal. Secrets must have been resolved by Validate.
+func (c *Config) BuilderConfig() *relayer.BuilderConfig {
+	b := c.Builder
+	cfg := &relayer.BuilderConfig{RemoteFallbackLocal: b.RemoteFallbackLocal}
+	if b.RemoteHost != "" {
+		cfg.Remote = &relayer.BuilderRemoteConfig{Host: b.RemoteHost, Token: b.RemoteToken.Value}
+	}
+	if cfg.Remote != nil && !b.RemoteFallbackLocal {
+		return cfg
+	}
+	if b.APIKey.Value != "" || b.Secret.Value != "" || b.Passphrase.Value != "" {
+		cfg.Local = &relayer.BuilderCredentials{Key: b.APIKey.Value, Secret: b.Secret.Value, Passphrase: b.Passphrase.Value}
+	}
+	return cfg
+}
+
+// Signer returns the private key signer, or nil when no private key is configured.
+// Secrets must have been resolved by Validate.
+func (c *Config) Signer() (*signer.PrivateKeySigner, error) {
+	if c.PrivateKey.Value == "" {
+		return nil, nil
+	}
+	return signer.NewPrivateKeySigner(c.PrivateKey.Value, c.ChainID)
+}
+
+// NewRelayClient validates the configuration and creates a RelayClient from it. opts are
+// applied after the loaded settings; the chain is looked up in the registry they select.
+func (c *Config) NewRelayClient(opts ...relayer.RelayClientOption) (*relayer.RelayClient, error) {
+	if err := c.validate(nil); err != nil {
+		return nil, err
+	}
+	base := []relayer.RelayClientOption{
+		relayer.WithBuilderConfig(c.BuilderConfig()),
+		relayer.WithRelayerTxType(c.TxType),
+	}
+	s, err := c.Signer()
+	if err != nil {
+		return nil, err
+	}
+	if s != nil {
+		base = append(base, relayer.WithSigner(s))
+	}
+	client, err := relayer.NewRelayClientWithOptions(c.RelayerURL, c.ChainID, append(base, opts...)...)
+	if errors.Is(err, types.ErrConfigUnsupported) {
+		return nil, fmt.Errorf("%w: %w", types.ErrInvalidConfig, err)
+	}
+	return client, err
+}
@@ -0,0 +1,253 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	relayer "github.com/GoPolymarket/go-builder-relayer-client"
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+const testPrivateKey = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func envLookup(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+const testYAML = `
+profile: prod
+builder:
+  apiKey: shared-key
+  secret: {file: secrets/builder-secret}
+  passphrase: shared-pass
+profiles:
+  prod:
+    relayerUrl: https://relayer-v2.polymarket.com/
+    chainId: 137
+  amoy:
+    relayerUrl: https://relayer.amoy.test
+    chainId: 80002
+    txType: PROXY
+    builder:
+      apiKey: amoy-key
+`
+
+func TestLoadFile_YAMLProfiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "secrets"), 0o700))
+	writeFile(t, dir, "secrets/builder-secret", "c2VjcmV0\n")
+	path := writeFile(t, dir, "relayer.yaml", testYAML)
+
+	cfg, err := LoadFile(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://relayer-v2.polymarket.com/", cfg.RelayerURL)
+	assert.Equal(t, int64(137), cfg.ChainID)
+	assert.Equal(t, "shared-key", cfg.Builder.APIKey.Value)
+	assert.Equal(t, filepath.Join(dir, "secrets/builder-secret"), cfg.Builder.Secret.File)
+
+	cfg, err = LoadFile(path, "amoy")
+	require.NoError(t, err)
+	assert.Equal(t, int64(80002), cfg.ChainID)
+	assert.Equal(t, types.RelayerTxProxy, cfg.TxType)
+	assert.Equal(t, "amoy-key", cfg.Builder.APIKey.Value)
+	assert.Equal(t, "shared-pass", cfg.Builder.Passphrase.Value)
+
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, "c2VjcmV0", cfg.Builder.Secret.Value)
+	assert.Equal(t, "amoy-key", cfg.BuilderConfig().Local.Key)
+
+	_, err = LoadFile(path, "staging")
+	assert.ErrorIs(t, err, types.ErrInvalidConfig)
+	assert.ErrorContains(t, err, "available: amoy, prod")
+}
+
+func TestLoadFile_JSON(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := writeFile(t, dir, "relayer.json", `{
+		"relayerUrl": "https://relayer.test",
+		"chainId": 137,
+		"builder": {"remoteHost": "https://signer.test/sign", "remoteToken": "token"}
+	}`)
+
+	cfg, err := LoadFile(path, "")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+	builderCfg := cfg.BuilderConfig()
+	require.NotNil(t, builderCfg.Remote)
+	assert.Equal(t, "token", builderCfg.Remote.Token)
+	assert.Nil(t, builderCfg.Local)
+
+	path = writeFile(t, dir, "typo.json", `{"relayer_url": "https://relayer.test"}`)
+	_, err = LoadFile(path, "")
+	assert.ErrorContains(t, err, "relayer_url")
+
+	path = writeFile(t, dir, "relayer.toml", ``)
+	_, err = LoadFile(path, "")
+	assert.ErrorIs(t, err, types.ErrInvalidConfig)
+}
+
+func TestLoad_EnvOverridesFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "secrets"), 0o700))
+	writeFile(t, dir, "secrets/builder-secret", "c2VjcmV0")
+	path := writeFile(t, dir, "relayer.yml", testYAML)
+	keyFile := writeFile(t, dir, "private-key", testPrivateKey+"\n")
+
+	cfg, err := load(envLookup(map[string]string{
+		EnvConfigFile:           path,
+		EnvProfile:              "amoy",
+		EnvRelayerURL:           "https://override.test",
+		EnvPrivateKey + "_FILE": keyFile,
+		EnvBuilderAPIKey:        "env-key",
+		EnvTxType:               "safe",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "https://override.test", cfg.RelayerURL)
+	assert.Equal(t, int64(80002), cfg.ChainID)
+	assert.Equal(t, types.RelayerTxSafe, cfg.TxType)
+	assert.Equal(t, testPrivateKey, cfg.PrivateKey.Value)
+	assert.Equal(t, "env-key", cfg.Builder.APIKey.Value)
+	assert.Equal(t, "[redacted]", cfg.PrivateKey.String())
+
+	client, err := cfg.NewRelayClient()
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestLoad_EnvOnly(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{
+		EnvRelayerURL:          "https://relayer.test",
+		EnvChainID:             "137",
+		EnvBuilderRemoteHost:   "https://signer.test/sign",
+		EnvBuilderAPIKey:       "key",
+		EnvBuilderSecret:       "c2VjcmV0",
+		EnvBuilderPassphrase:   "pass",
+		EnvRemoteFallbackLocal: "true",
+	}
+	cfg, err := load(envLookup(env))
+	require.NoError(t, err)
+	builderCfg := cfg.BuilderConfig()
+	assert.True(t, builderCfg.RemoteFallbackLocal)
+	assert.NotNil(t, builderCfg.Remote)
+	assert.NotNil(t, builderCfg.Local)
+
+	env[EnvBuilderSecret+"_FILE"] = "/run/secrets/builder-secret"
+	_, err = load(envLookup(env))
+	assert.ErrorIs(t, err, types.ErrInvalidConfig)
+	delete(env, EnvBuilderSecret+"_FILE")
+
+	env[EnvChainID] = "polygon"
+	_, err = load(envLookup(env))
+	assert.ErrorIs(t, err, types.ErrInvalidConfig)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() Config {
+		return Config{
+			RelayerURL: "https://relayer.test",
+			ChainID:    137,
+			Builder:    Builder{APIKey: Secret{Value: "key"}, Secret: Secret{Value: "c2VjcmV0"}, Passphrase: Secret{Value: "pass"}},
+		}
+	}
+	cfg := valid()
+	require.NoError(t, cfg.Validate())
+
+	tests := map[string]func(*Config){
+		"missing URL":      func(c *Config) { c.RelayerURL = "" },
+		"relative URL":     func(c *Config) { c.RelayerURL = "relayer.test" },
+		"unsupported URL":  func(c *Config) { c.RelayerURL = "ftp://relayer.test" },
+		"missing chain":    func(c *Config) { c.ChainID = 0 },
+		"unknown chain":    func(c *Config) { c.ChainID = 31337 },
+		"invalid tx type":  func(c *Config) { c.TxType = "EOA" },
+		"invalid key":      func(c *Config) { c.PrivateKey = Secret{Value: "0x1234"} },
+		"missing builder":  func(c *Config) { c.Builder = Builder{} },
+		"partial builder":  func(c *Config) { c.Builder.Passphrase = Secret{} },
+		"bad remote host":  func(c *Config) { c.Builder.RemoteHost = "signer.test" },
+		"missing file":     func(c *Config) { c.Builder.Secret = Secret{File: filepath.Join(t.TempDir(), "missing")} },
+		"value and a file": func(c *Config) { c.Builder.Secret.File = "/run/secrets/builder-secret" },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cfg := valid()
+			mutate(&cfg)
+			assert.Error(t, cfg.Validate())
+		})
+	}
+}
+
+func TestConfig_BuilderConfigPrefersRemoteHost(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		RelayerURL: "https://relayer.test",
+		ChainID:    137,
+		Builder: Builder{
+			APIKey:     Secret{Value: "leftover-key"},
+			RemoteHost: "https://signer.test",
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	builder := cfg.BuilderConfig()
+	require.NotNil(t, builder.Remote)
+	assert.Nil(t, builder.Local)
+
+	cfg.Builder.Secret = Secret{Value: "c2VjcmV0"}
+	cfg.Builder.Passphrase = Secret{Value: "pass"}
+	cfg.Builder.RemoteFallbackLocal = true
+	builder = cfg.BuilderConfig()
+	require.NotNil(t, builder.Remote)
+	require.NotNil(t, builder.Local)
+	assert.Equal(t, "leftover-key", builder.Local.Key)
+}
+
+func TestConfig_ValidatesAgainstCustomRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := relayer.NewContractRegistry()
+	require.NoError(t, registry.Register(31337, types.ContractConfig{
+		SafeContracts: types.SafeContractConfig{
+			SafeFactory:   "0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b",
+			SafeMultisend: "0xA238CBeb142c10Ef7Ad8442C6D1f9E89e07e7761",
+		},
+	}))
+	cfg := Config{
+		RelayerURL: "https://relayer.test",
+		ChainID:    31337,
+		Builder:    Builder{APIKey: Secret{Value: "key"}, Secret: Secret{Value: "c2VjcmV0"}, Passphrase: Secret{Value: "pass"}},
+	}
+
+	assert.ErrorIs(t, cfg.Validate(), types.ErrInvalidConfig)
+	require.NoError(t, cfg.ValidateWithRegistry(registry))
+
+	client, err := cfg.NewRelayClient(relayer.WithContractRegistry(registry))
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+
+	_, err = cfg.NewRelayClient()
+	assert.ErrorIs(t, err, types.ErrInvalidConfig)
+}
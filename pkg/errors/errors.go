@@ -30,6 +30,14 @@ const (
 	CodeInvalidNoncePayload ErrorCode = "RELAYER-007"
 	CodeTransactionFailed   ErrorCode = "RELAYER-008"
 	CodeTransactionTimeout  ErrorCode = "RELAYER-009"
+	CodeBadSignature        ErrorCode = "RELAYER-010"
+	CodeNonceMismatch       ErrorCode = "RELAYER-011"
+	CodeUnsupportedWallet   ErrorCode = "RELAYER-012"
+	CodeUnknownProxy        ErrorCode = "RELAYER-013"
+	CodeDelegateCallDenied  ErrorCode = "RELAYER-014"
+	CodeInvalidOperation    ErrorCode = "RELAYER-015"
+	CodeInvalidIdempotency  ErrorCode = "RELAYER-016"
+	CodeInvalidConfig       ErrorCode = "RELAYER-017"
 
 	// CLOB API error codes (CLOB-xxx)
 	CodeInsufficientFunds ErrorCode = "CLOB-001"
@@ -140,6 +148,22 @@ var (
 	ErrTransactionFailed = New(CodeTransactionFailed, "transaction failed onchain")
 	// ErrTransactionTimeout is returned when a transaction does not reach a desired state in time.
 	ErrTransactionTimeout = New(CodeTransactionTimeout, "transaction not found or not in desired state (timeout)")
+	// ErrBadSignature is returned when the relayer rejects a transaction signature.
+	ErrBadSignature = New(CodeBadSignature, "relayer rejected the transaction signature")
+	// ErrNonceMismatch is returned when the relayer rejects a transaction nonce.
+	ErrNonceMismatch = New(CodeNonceMismatch, "relayer rejected the transaction nonce")
+	// ErrUnsupportedWallet is returned when the relayer does not support the wallet type.
+	ErrUnsupportedWallet = New(CodeUnsupportedWallet, "relayer does not support the wallet type")
+	// ErrUnknownProxy is returned when the relayer does not recognise the proxy wallet.
+	ErrUnknownProxy = New(CodeUnknownProxy, "relayer does not recognise the proxy wallet")
+	// ErrDelegateCallDenied is returned when a delegate call targets a contract outside the allowlist.
+	ErrDelegateCallDenied = New(CodeDelegateCallDenied, "delegate call target is not allowlisted")
+	// ErrInvalidOperation is returned when a transaction has an unknown operation or call type.
+	ErrInvalidOperation = New(CodeInvalidOperation, "invalid transaction operation")
+	// ErrInvalidIdempotencyKey is returned when an idempotency key is empty or contains whitespace.
+	ErrInvalidIdempotencyKey = New(CodeInvalidIdempotency, "invalid idempotency key")
+	// ErrInvalidConfig is returned when a loaded client configuration fails validation.
+	ErrInvalidConfig = New(CodeInvalidConfig, "invalid client configuration")
 )
 
 // Backwards-compatible aliases for existing error names.
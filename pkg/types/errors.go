@@ -1,17 +1,43 @@
 package types
 
-import sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+import (
+	"fmt"
+
+	sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+)
 
 var (
-	ErrSignerUnavailable    = sdkerrors.ErrSignerUnavailable
-	ErrSafeDeployed         = sdkerrors.ErrSafeDeployed
-	ErrSafeNotDeployed      = sdkerrors.ErrSafeNotDeployed
-	ErrConfigUnsupported    = sdkerrors.ErrConfigUnsupported
-	ErrMissingBuilderConfig = sdkerrors.ErrMissingBuilderConfig
-	ErrMissingGasEstimator  = sdkerrors.ErrMissingGasEstimator
-	ErrNoTransactions       = sdkerrors.ErrNoTransactions
-	ErrUnsupportedTxType    = sdkerrors.ErrUnsupportedTxType
-	ErrInvalidNoncePayload  = sdkerrors.ErrInvalidNoncePayload
-	ErrTransactionFailed    = sdkerrors.ErrTransactionFailed
-	ErrTransactionTimeout   = sdkerrors.ErrTransactionTimeout
+	ErrSignerUnavailable     = sdkerrors.ErrSignerUnavailable
+	ErrSafeDeployed          = sdkerrors.ErrSafeDeployed
+	ErrSafeNotDeployed       = sdkerrors.ErrSafeNotDeployed
+	ErrConfigUnsupported     = sdkerrors.ErrConfigUnsupported
+	ErrMissingBuilderConfig  = sdkerrors.ErrMissingBuilderConfig
+	ErrMissingGasEstimator   = sdkerrors.ErrMissingGasEstimator
+	ErrNoTransactions        = sdkerrors.ErrNoTransactions
+	ErrUnsupportedTxType     = sdkerrors.ErrUnsupportedTxType
+	ErrInvalidNoncePayload   = sdkerrors.ErrInvalidNoncePayload
+	ErrTransactionFailed     = sdkerrors.ErrTransactionFailed
+	ErrTransactionTimeout    = sdkerrors.ErrTransactionTimeout
+	ErrBadSignature          = sdkerrors.ErrBadSignature
+	ErrNonceMismatch         = sdkerrors.ErrNonceMismatch
+	ErrUnsupportedWallet     = sdkerrors.ErrUnsupportedWallet
+	ErrUnknownProxy          = sdkerrors.ErrUnknownProxy
+	ErrDelegateCallDenied    = sdkerrors.ErrDelegateCallDenied
+	ErrInvalidOperation      = sdkerrors.ErrInvalidOperation
+	ErrInvalidIdempotencyKey = sdkerrors.ErrInvalidIdempotencyKey
+	ErrInvalidConfig         = sdkerrors.ErrInvalidConfig
 )
+
+// TransactionFailedError is returned when a transaction ends in a failure state.
+// It matches ErrTransactionFailed with errors.Is and carries the final relayer record.
+type TransactionFailedError struct {
+	Transaction RelayerTransaction
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("%s: id=%s state=%s hash=%s", ErrTransactionFailed.Error(), e.Transaction.TransactionID, e.Transaction.State, e.Transaction.TransactionHash)
+}
+
+func (e *TransactionFailedError) Unwrap() error {
+	return ErrTransactionFailed
+}
@@ -136,6 +136,35 @@ const (
 	StateFailed    RelayerTransactionState = "STATE_FAILED"
 )
 
+// successRank orders the states on the success path: NEW -> EXECUTED -> MINED -> CONFIRMED.
+var successRank = map[RelayerTransactionState]int{
+	StateNew:       1,
+	StateExecuted:  2,
+	StateMined:     3,
+	StateConfirmed: 4,
+}
+
+// IsTerminal reports whether the relayer will not move the transaction to another state.
+func (s RelayerTransactionState) IsTerminal() bool {
+	return s == StateConfirmed || s.IsFailure()
+}
+
+// IsFailure reports whether the state is a terminal failure (FAILED or INVALID).
+func (s RelayerTransactionState) IsFailure() bool {
+	return s == StateFailed || s == StateInvalid
+}
+
+// Reached reports whether s is at or beyond threshold on the success path.
+// Failure states never reach a threshold.
+func (s RelayerTransactionState) Reached(threshold RelayerTransactionState) bool {
+	rank, ok := successRank[s]
+	if !ok {
+		return false
+	}
+	want, ok := successRank[threshold]
+	return ok && rank >= want
+}
+
 type RelayerTransaction struct {
 	TransactionID   string    `json:"transactionID"`
 	TransactionHash string    `json:"transactionHash"`
@@ -164,16 +193,22 @@ type GetDeployedResponse struct {
 }
 
 type ProxyContractConfig struct {
-	RelayHub     string
-	ProxyFactory string
+	RelayHub     string `json:"relayHub"`
+	ProxyFactory string `json:"proxyFactory"`
+	// InitCodeHash is the proxy wallet init code hash used for address derivation.
+	// Empty means ProxyInitCodeHash.
+	InitCodeHash string `json:"initCodeHash,omitempty"`
 }
 
 type SafeContractConfig struct {
-	SafeFactory   string
-	SafeMultisend string
+	SafeFactory   string `json:"safeFactory"`
+	SafeMultisend string `json:"safeMultisend"`
+	// InitCodeHash is the Safe proxy init code hash used for address derivation.
+	// Empty means SafeInitCodeHash.
+	InitCodeHash string `json:"initCodeHash,omitempty"`
 }
 
 type ContractConfig struct {
-	ProxyContracts ProxyContractConfig
-	SafeContracts  SafeContractConfig
+	ProxyContracts ProxyContractConfig `json:"proxyContracts"`
+	SafeContracts  SafeContractConfig  `json:"safeContracts"`
 }
\ No newline at end of file
@@ -0,0 +1,204 @@
+package relayer
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+const (
+	polygonBlockTime      = 2 * time.Second
+	polygonFastPolls      = 8
+	polygonSlowInterval   = 15 * time.Second
+	defaultPollMultiplier = 2.0
+	// minPollDelay keeps a strategy returning zero or tiny delays from polling in a tight loop.
+	minPollDelay = time.Second
+)
+
+// PollStrategy decides how long to wait between transaction status polls.
+type PollStrategy interface {
+	// Delay returns the wait after the poll with the given 0-based index.
+	Delay(poll int) time.Duration
+}
+
+// FixedPoll waits the same Interval between every poll.
+type FixedPoll struct {
+	Interval time.Duration
+}
+
+func (p FixedPoll) Delay(int) time.Duration {
+	return p.Interval
+}
+
+// ExponentialPoll starts at Initial and multiplies the delay by Multiplier (default 2) after each poll, up to Max.
+type ExponentialPoll struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+func (p ExponentialPoll) Delay(poll int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 1 {
+		multiplier = defaultPollMultiplier
+	}
+	delay := float64(p.Initial)
+	for i := 0; i < poll; i++ {
+		delay *= multiplier
+		if p.Max > 0 && delay >= float64(p.Max) {
+			return p.Max
+		}
+	}
+	if p.Max > 0 && delay > float64(p.Max) {
+		return p.Max
+	}
+	return time.Duration(delay)
+}
+
+// JitteredPoll randomises the delays of Base by up to ±Fraction (0..1) so that
+// many waiters started together do not poll in lockstep.
+type JitteredPoll struct {
+	Base     PollStrategy
+	Fraction float64
+
+	random func() float64
+}
+
+func (p JitteredPoll) Delay(poll int) time.Duration {
+	if p.Base == nil {
+		return 0
+	}
+	delay := p.Base.Delay(poll)
+	fraction := p.Fraction
+	if fraction <= 0 || delay <= 0 {
+		return delay
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	random := p.random
+	if random == nil {
+		random = rand.Float64
+	}
+	return time.Duration(float64(delay) * (1 + fraction*(2*random()-1)))
+}
+
+// FastThenSlowPoll waits Fast for the first FastPolls polls and then switches to Slow.
+type FastThenSlowPoll struct {
+	Fast      time.Duration
+	FastPolls int
+	Slow      PollStrategy
+}
+
+func (p FastThenSlowPoll) Delay(poll int) time.Duration {
+	if poll < p.FastPolls || p.Slow == nil {
+		return p.Fast
+	}
+	return p.Slow.Delay(poll - p.FastPolls)
+}
+
+// PolygonPollStrategy polls once per Polygon block while a transaction usually lands,
+// then backs off to at most one poll every 15 seconds.
+func PolygonPollStrategy() PollStrategy {
+	return FastThenSlowPoll{
+		Fast:      polygonBlockTime,
+		FastPolls: polygonFastPolls,
+		Slow:      ExponentialPoll{Initial: 2 * polygonBlockTime, Max: polygonSlowInterval},
+	}
+}
+
+// PollOptions configures PollUntilStateWithOptions.
+type PollOptions struct {
+	// Strategy defaults to FixedPoll{2s}. Delays below one second are raised to one second.
+	Strategy PollStrategy
+	// MaxPolls bounds the number of polls. Zero means unbounded when Timeout is set, otherwise 10.
+	MaxPolls int
+	// Timeout bounds the total wait, including each GetTransaction request. Zero means no
+	// deadline other than ctx.
+	Timeout time.Duration
+}
+
+// PollUntilStateWithOptions polls a transaction until it reaches one of states, using a
+// pluggable delay schedule and an optional deadline. It returns types.ErrTransactionTimeout
+// when MaxPolls or Timeout is exhausted, and a *types.TransactionFailedError on failure states.
+func (c *RelayClient) PollUntilStateWithOptions(ctx context.Context, transactionID string, states []types.RelayerTransactionState, failState types.RelayerTransactionState, opts PollOptions) (*types.RelayerTransaction, error) {
+	stateSet := make(map[types.RelayerTransactionState]struct{}, len(states))
+	for _, s := range states {
+		stateSet[s] = struct{}{}
+	}
+
+	strategy := opts.Strategy
+	if strategy == nil {
+		strategy = FixedPoll{Interval: 2 * time.Second}
+	}
+	maxPolls := opts.MaxPolls
+	if maxPolls <= 0 && opts.Timeout <= 0 {
+		maxPolls = 10
+	}
+	var deadline time.Time
+	pollCtx := ctx
+	if opts.Timeout > 0 {
+		deadline = time.Now().Add(opts.Timeout)
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+	sleepFn := c.sleepFn
+	if sleepFn == nil {
+		sleepFn = sleepWithContext
+	}
+
+	var lastState types.RelayerTransactionState
+	for i := 0; maxPolls <= 0 || i < maxPolls; i++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		txns, err := c.GetTransaction(pollCtx, transactionID)
+		if err != nil {
+			if ctx.Err() == nil && pollCtx.Err() != nil {
+				return nil, types.ErrTransactionTimeout
+			}
+			return nil, err
+		}
+		if len(txns) > 0 {
+			txn := txns[0]
+			if txn.State != lastState {
+				lastState = txn.State
+				// The observed state is still valid when journaling fails, so don't discard it.
+				if err := c.journalState(ctx, txn); err != nil {
+					c.log().Warn("record state %s of transaction %s: %v", txn.State, txn.TransactionID, err)
+				}
+			}
+			if _, ok := stateSet[txn.State]; ok {
+				return &txn, nil
+			}
+			// Any terminal failure ends polling, not only failState, since the state can no longer change.
+			if (failState != "" && txn.State == failState) || txn.State.IsFailure() {
+				return nil, &types.TransactionFailedError{Transaction: txn}
+			}
+		}
+
+		if i == maxPolls-1 {
+			continue
+		}
+		delay := max(strategy.Delay(i), minPollDelay)
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				break
+			}
+			if delay > remaining {
+				delay = remaining
+			}
+		}
+		if err := sleepFn(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+	return nil, types.ErrTransactionTimeout
+}
@@ -0,0 +1,130 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestPollStrategies_Delays(t *testing.T) {
+	t.Parallel()
+
+	exp := ExponentialPoll{Initial: time.Second, Max: 5 * time.Second}
+	assert.Equal(t, time.Second, exp.Delay(0))
+	assert.Equal(t, 4*time.Second, exp.Delay(2))
+	assert.Equal(t, 5*time.Second, exp.Delay(10))
+
+	jittered := JitteredPoll{Base: FixedPoll{Interval: time.Second}, Fraction: 0.5, random: func() float64 { return 1 }}
+	assert.Equal(t, 1500*time.Millisecond, jittered.Delay(0))
+	jittered.random = func() float64 { return 0 }
+	assert.Equal(t, 500*time.Millisecond, jittered.Delay(0))
+
+	polygon := PolygonPollStrategy()
+	assert.Equal(t, polygonBlockTime, polygon.Delay(0))
+	assert.Equal(t, polygonBlockTime, polygon.Delay(polygonFastPolls-1))
+	assert.Equal(t, 2*polygonBlockTime, polygon.Delay(polygonFastPolls))
+	assert.Equal(t, polygonSlowInterval, polygon.Delay(polygonFastPolls+20))
+}
+
+func TestPollUntilStateWithOptions_UsesStrategyDelays(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	var delays []time.Duration
+	client.sleepFn = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	_, err := client.PollUntilStateWithOptions(context.Background(), "tx-id",
+		[]types.RelayerTransactionState{types.StateMined}, types.StateFailed,
+		PollOptions{Strategy: ExponentialPoll{Initial: time.Second, Max: 3 * time.Second}, MaxPolls: 4})
+
+	require.ErrorIs(t, err, types.ErrTransactionTimeout)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, delays)
+}
+
+func TestPollUntilStateWithOptions_DeadlineMode(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+
+	start := time.Now()
+	_, err := client.PollUntilStateWithOptions(context.Background(), "tx-id",
+		[]types.RelayerTransactionState{types.StateMined}, types.StateFailed,
+		PollOptions{Strategy: FixedPoll{Interval: 20 * time.Millisecond}, Timeout: 100 * time.Millisecond})
+	elapsed := time.Since(start)
+
+	require.ErrorIs(t, err, types.ErrTransactionTimeout)
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+	assert.Less(t, elapsed, 500*time.Millisecond)
+}
+
+func TestPollUntilStateWithOptions_FloorsDelay(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	var delays []time.Duration
+	client.sleepFn = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	_, err := client.PollUntilStateWithOptions(context.Background(), "tx-id",
+		[]types.RelayerTransactionState{types.StateMined}, types.StateFailed,
+		PollOptions{Strategy: FixedPoll{}, MaxPolls: 3})
+
+	require.ErrorIs(t, err, types.ErrTransactionTimeout)
+	assert.Equal(t, []time.Duration{minPollDelay, minPollDelay}, delays)
+}
+
+func TestPollUntilStateWithOptions_TimeoutBoundsRequests(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})}))
+
+	start := time.Now()
+	_, err := client.PollUntilStateWithOptions(context.Background(), "tx-id",
+		[]types.RelayerTransactionState{types.StateMined}, types.StateFailed,
+		PollOptions{Timeout: 100 * time.Millisecond})
+
+	require.ErrorIs(t, err, types.ErrTransactionTimeout)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+type failingJournal struct{ MemoryJournal }
+
+func (*failingJournal) Append(context.Context, JournalEntry) error {
+	return errors.New("disk full")
+}
+
+func TestPollUntilStateWithOptions_KeepsResultWhenJournalFails(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	log := &recordingLogger{}
+	client.logger = log
+	client.SetJournal(&failingJournal{})
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `[{"transactionID":"tx-id","state":"STATE_MINED"}]`, nil), nil
+	})}))
+
+	txn, err := client.PollUntilStateWithOptions(context.Background(), "tx-id",
+		[]types.RelayerTransactionState{types.StateMined}, types.StateFailed, PollOptions{MaxPolls: 1})
+
+	require.NoError(t, err)
+	require.NotNil(t, txn)
+	assert.Equal(t, types.StateMined, txn.State)
+	assert.Len(t, log.warnings, 1)
+}
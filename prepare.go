@@ -0,0 +1,88 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// PreparedTransaction is a signed relayer transaction request that has not been submitted.
+type PreparedTransaction struct {
+	// Request is the signed transaction request.
+	Request *types.TransactionRequest
+	// Hash is the signed digest: the Safe transaction hash for SAFE requests,
+	// or the proxy struct hash for PROXY requests.
+	Hash string
+	// Body is the exact JSON body that is POSTed to SubmitTransactionEndpoint.
+	Body []byte
+
+	// nonceKey is set when the nonce was reserved from the client's NonceManager.
+	nonceKey string
+}
+
+func newPreparedTransaction(request *types.TransactionRequest, hash []byte) (*PreparedTransaction, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+	return &PreparedTransaction{
+		Request: request,
+		Hash:    hexutil.Encode(hash),
+		Body:    body,
+	}, nil
+}
+
+// Prepare performs the relayer lookups (deployment, nonce or relay payload), builds and signs
+// the transaction request for a batch of transactions, and returns it without submitting.
+// Execute is equivalent to Prepare followed by a POST of the prepared body.
+// With a NonceManager set, Prepare uses the next Safe nonce without reserving it, so a
+// discarded request leaves no gap; Submit marks the nonce as used when it relays the request.
+func (c *RelayClient) Prepare(ctx context.Context, txns []types.Transaction, metadata string) (*PreparedTransaction, error) {
+	return c.prepare(ctx, txns, metadata, false)
+}
+
+func (c *RelayClient) prepare(ctx context.Context, txns []types.Transaction, metadata string, reserve bool) (*PreparedTransaction, error) {
+	if c.signer == nil {
+		return nil, types.ErrSignerUnavailable
+	}
+	if len(txns) == 0 {
+		return nil, types.ErrNoTransactions
+	}
+
+	switch c.relayTxType {
+	case types.RelayerTxSafe:
+		return c.prepareSafeTransactions(ctx, toSafeTransactions(txns), metadata, reserve)
+	case types.RelayerTxProxy:
+		return c.prepareProxyTransactions(ctx, toProxyTransactions(txns), metadata)
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrUnsupportedTxType, c.relayTxType)
+	}
+}
+
+func toSafeTransactions(txns []types.Transaction) []types.SafeTransaction {
+	safeTxns := make([]types.SafeTransaction, 0, len(txns))
+	for _, tx := range txns {
+		value := tx.Value
+		if value == "" {
+			value = "0"
+		}
+		safeTxns = append(safeTxns, types.SafeTransaction{To: tx.To, Operation: types.OperationCall, Data: tx.Data, Value: value})
+	}
+	return safeTxns
+}
+
+func toProxyTransactions(txns []types.Transaction) []types.ProxyTransaction {
+	proxyTxns := make([]types.ProxyTransaction, 0, len(txns))
+	for _, tx := range txns {
+		value := tx.Value
+		if value == "" {
+			value = "0"
+		}
+		proxyTxns = append(proxyTxns, types.ProxyTransaction{To: tx.To, TypeCode: types.CallTypeCall, Data: tx.Data, Value: value})
+	}
+	return proxyTxns
+}
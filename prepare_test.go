@@ -0,0 +1,46 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func testBuilderConfig() *BuilderConfig {
+	return &BuilderConfig{
+		Local: &BuilderCredentials{
+			Key:        "test-key",
+			Secret:     "c2VjcmV0", // base64("secret")
+			Passphrase: "test-pass",
+		},
+	}
+}
+
+func TestPrepare_SafeBuildsSignedRequestWithoutSubmitting(t *testing.T) {
+	t.Parallel()
+
+	client, fake := newFakeRelayer(t, map[string]http.HandlerFunc{
+		GetNonceEndpoint: respond(http.StatusOK, `{"nonce":"7"}`),
+	})
+
+	prepared, err := client.Prepare(context.Background(), []types.Transaction{{
+		To:   "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174",
+		Data: "0x",
+	}}, "risk-review")
+	require.NoError(t, err)
+
+	assert.Zero(t, fake.Calls(SubmitTransactionEndpoint))
+	assert.Equal(t, "7", prepared.Request.Nonce)
+	assert.Equal(t, "risk-review", prepared.Request.Metadata)
+	assert.Len(t, prepared.Hash, 66)
+
+	var decoded types.TransactionRequest
+	require.NoError(t, json.Unmarshal(prepared.Body, &decoded))
+	assert.Equal(t, *prepared.Request, decoded)
+}
@@ -0,0 +1,147 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit describes a token bucket budget.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate. Zero or negative means unlimited.
+	RequestsPerSecond float64
+	// Burst is the bucket capacity. Defaults to 1 when a rate is set.
+	Burst int
+}
+
+// RateLimitConfig configures the client-side rate limiter installed by WithRateLimit.
+type RateLimitConfig struct {
+	// Global applies to every request made through the HTTPClient.
+	Global RateLimit
+	// Endpoints holds additional budgets keyed by endpoint path, e.g. SubmitTransactionEndpoint.
+	Endpoints map[string]RateLimit
+}
+
+// WithRateLimit enables a token-bucket rate limiter shared by all callers of the HTTPClient.
+// Requests block until a token is available or the request context is done.
+// A 429 response with Retry-After pauses the bucket that governs the endpoint (its own
+// budget if configured, otherwise the global one) so every caller backs off together.
+func WithRateLimit(config RateLimitConfig) HTTPClientOption {
+	return func(c *HTTPClient) { c.limiter = newRateLimiter(config) }
+}
+
+type rateLimiter struct {
+	global    *tokenBucket
+	endpoints map[string]*tokenBucket
+	now       func() time.Time
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	now := time.Now
+	l := &rateLimiter{
+		global:    newTokenBucket(config.Global, now()),
+		endpoints: make(map[string]*tokenBucket, len(config.Endpoints)),
+		now:       now,
+	}
+	for endpoint, limit := range config.Endpoints {
+		l.endpoints[endpoint] = newTokenBucket(limit, now())
+	}
+	return l
+}
+
+// wait blocks until the request for endpoint may be sent.
+func (l *rateLimiter) wait(ctx context.Context, endpoint string) error {
+	if l == nil {
+		return nil
+	}
+	if bucket, ok := l.endpoints[endpoint]; ok {
+		if err := bucket.wait(ctx, l.now); err != nil {
+			return err
+		}
+	}
+	return l.global.wait(ctx, l.now)
+}
+
+// pause stops issuing tokens for endpoint's governing bucket until d has elapsed.
+// It reports whether a pause was applied, in which case wait already enforces d.
+func (l *rateLimiter) pause(endpoint string, d time.Duration) bool {
+	if l == nil || d <= 0 {
+		return false
+	}
+	bucket, ok := l.endpoints[endpoint]
+	if !ok {
+		bucket = l.global
+	}
+	bucket.pause(l.now().Add(d))
+	return true
+}
+
+type tokenBucket struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   now,
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context, now func() time.Time) error {
+	for {
+		delay := b.reserve(now())
+		if delay <= 0 {
+			return nil
+		}
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait before trying again.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+	if b.rate <= 0 {
+		return 0
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) pause(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	// Drain the bucket so callers resume at the sustained rate instead of bursting.
+	b.tokens = 0
+	b.last = until
+}
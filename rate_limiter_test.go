@@ -0,0 +1,92 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket_ReserveRefillsAtRate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 10, Burst: 2}, start)
+
+	assert.Zero(t, bucket.reserve(start))
+	assert.Zero(t, bucket.reserve(start))
+	assert.Equal(t, 100*time.Millisecond, bucket.reserve(start))
+	assert.Zero(t, bucket.reserve(start.Add(100*time.Millisecond)))
+}
+
+func TestTokenBucket_PauseBlocksUnlimitedBucket(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	bucket := newTokenBucket(RateLimit{}, start)
+	assert.Zero(t, bucket.reserve(start))
+
+	bucket.pause(start.Add(2 * time.Second))
+	assert.Equal(t, 2*time.Second, bucket.reserve(start))
+	assert.Zero(t, bucket.reserve(start.Add(2*time.Second)))
+}
+
+func TestRateLimiter_WaitRespectsContext(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(RateLimitConfig{
+		Endpoints: map[string]RateLimit{SubmitTransactionEndpoint: {RequestsPerSecond: 0.1, Burst: 1}},
+	})
+	require.NoError(t, limiter.wait(context.Background(), SubmitTransactionEndpoint))
+	// Other endpoints only use the (unlimited) global budget.
+	require.NoError(t, limiter.wait(context.Background(), GetTransactionEndpoint))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := limiter.wait(ctx, SubmitTransactionEndpoint)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestHTTPClientDo_RetryAfterPausesSharedLimiter(t *testing.T) {
+	t.Parallel()
+
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, `{"error":"rate limited"}`, map[string]string{"Retry-After": "30"}), nil
+	})}
+	client := NewHTTPClient(base, WithMaxRetries(0), WithRateLimit(RateLimitConfig{}))
+
+	err := client.Do(context.Background(), http.MethodGet, "https://relayer.test/transaction", &RequestOptions{Endpoint: GetTransactionEndpoint}, nil)
+	require.Error(t, err)
+
+	delay := client.limiter.global.reserve(time.Now())
+	assert.Greater(t, delay, 25*time.Second, "429 with Retry-After should pause the global bucket")
+}
+
+func TestHTTPClientDo_RetryAfterWaitsOnceWithLimiter(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return newResponse(http.StatusTooManyRequests, `{"error":"rate limited"}`, map[string]string{"Retry-After": "1"}), nil
+		}
+		return newResponse(http.StatusOK, `{}`, nil), nil
+	})}
+	var delays []time.Duration
+	client := NewHTTPClient(base, WithMaxRetries(1), WithRateLimit(RateLimitConfig{}), WithInterceptors(Interceptor{
+		OnRetry: func(_ context.Context, _ RequestInfo, delay time.Duration, _ error) { delays = append(delays, delay) },
+	}))
+
+	start := time.Now()
+	err := client.Do(context.Background(), http.MethodGet, "https://relayer.test/transaction", &RequestOptions{Endpoint: GetTransactionEndpoint}, nil)
+	elapsed := time.Since(start)
+
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{0}, delays, "the limiter pause already enforces Retry-After")
+	assert.GreaterOrEqual(t, elapsed, 900*time.Millisecond)
+	assert.Less(t, elapsed, 1800*time.Millisecond)
+}
@@ -0,0 +1,145 @@
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// DefaultContractRegistry holds the Polymarket deployments on Polygon (137) and Amoy (80002).
+// It is used by GetContractConfig, NewRelayClient and the Derive helpers, and may be extended
+// at runtime with Register or LoadJSON.
+var DefaultContractRegistry = newDefaultContractRegistry()
+
+// ContractRegistry maps chain IDs to the contracts used by the relayer.
+// It is safe for concurrent use.
+type ContractRegistry struct {
+	mu      sync.RWMutex
+	configs map[int64]types.ContractConfig
+}
+
+// NewContractRegistry creates an empty ContractRegistry.
+func NewContractRegistry() *ContractRegistry {
+	return &ContractRegistry{configs: make(map[int64]types.ContractConfig)}
+}
+
+func newDefaultContractRegistry() *ContractRegistry {
+	r := NewContractRegistry()
+	r.configs[137] = polygonConfig
+	r.configs[80002] = amoyConfig
+	return r
+}
+
+// Register adds or replaces the contract config for chainID. Empty fields are allowed, for
+// example on chains without proxy wallets, but non-empty addresses and hashes must be valid hex.
+func (r *ContractRegistry) Register(chainID int64, config types.ContractConfig) error {
+	if err := validateContractConfig(config); err != nil {
+		return fmt.Errorf("chain %d: %w", chainID, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.configs[chainID] = config
+	return nil
+}
+
+// Get returns the contract config for chainID, or types.ErrConfigUnsupported.
+func (r *ContractRegistry) Get(chainID int64) (types.ContractConfig, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	config, ok := r.configs[chainID]
+	if !ok {
+		return types.ContractConfig{}, fmt.Errorf("%w: chain %d", types.ErrConfigUnsupported, chainID)
+	}
+	return config, nil
+}
+
+// Chains returns the registered chain IDs in ascending order.
+func (r *ContractRegistry) Chains() []int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	chains := make([]int64, 0, len(r.configs))
+	for chainID := range r.configs {
+		chains = append(chains, chainID)
+	}
+	slices.Sort(chains)
+	return chains
+}
+
+// LoadJSON registers every chain of a JSON object keyed by chain ID, e.g.
+//
+//	{"31337": {"safeContracts": {"safeFactory": "0x...", "safeMultisend": "0x...", "initCodeHash": "0x..."},
+//	           "proxyContracts": {"proxyFactory": "0x...", "relayHub": "0x..."}}}
+//
+// Nothing is registered if any entry is invalid.
+func (r *ContractRegistry) LoadJSON(data []byte) error {
+	var raw map[string]types.ContractConfig
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("decode contract registry: %w", err)
+	}
+	configs := make(map[int64]types.ContractConfig, len(raw))
+	for key, config := range raw {
+		chainID, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return fmt.Errorf("decode contract registry: invalid chain id %q", key)
+		}
+		if err := validateContractConfig(config); err != nil {
+			return fmt.Errorf("chain %d: %w", chainID, err)
+		}
+		configs[chainID] = config
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for chainID, config := range configs {
+		r.configs[chainID] = config
+	}
+	return nil
+}
+
+// LoadContractRegistryFile creates a ContractRegistry from a JSON file in the LoadJSON format.
+func LoadContractRegistryFile(path string) (*ContractRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read contract registry: %w", err)
+	}
+	r := NewContractRegistry()
+	if err := r.LoadJSON(data); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func validateContractConfig(config types.ContractConfig) error {
+	addresses := map[string]string{
+		"proxyContracts.relayHub":     config.ProxyContracts.RelayHub,
+		"proxyContracts.proxyFactory": config.ProxyContracts.ProxyFactory,
+		"safeContracts.safeFactory":   config.SafeContracts.SafeFactory,
+		"safeContracts.safeMultisend": config.SafeContracts.SafeMultisend,
+	}
+	for field, address := range addresses {
+		if address != "" && !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid address for %s: %q", field, address)
+		}
+	}
+	hashes := map[string]string{
+		"proxyContracts.initCodeHash": config.ProxyContracts.InitCodeHash,
+		"safeContracts.initCodeHash":  config.SafeContracts.InitCodeHash,
+	}
+	for field, hash := range hashes {
+		if hash == "" {
+			continue
+		}
+		if decoded, err := hexutil.Decode(hash); err != nil || len(decoded) != common.HashLength {
+			return fmt.Errorf("invalid hash for %s: %q", field, hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package relayer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestContractRegistry_LoadJSONAndClient(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "contracts.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"31337": {
+			"safeContracts": {
+				"safeFactory": "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+				"safeMultisend": "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512",
+				"initCodeHash": "0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf"
+			}
+		}
+	}`), 0o600))
+
+	registry, err := LoadContractRegistryFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{31337}, registry.Chains())
+
+	config, err := registry.Get(31337)
+	require.NoError(t, err)
+	assert.Equal(t, "0x5FbDB2315678afecb367f032d93F642f64180aa3", config.SafeContracts.SafeFactory)
+	assert.False(t, IsProxyContractConfigValid(config.ProxyContracts))
+
+	client, err := NewRelayClientWithRegistry("https://example.test", 31337, nil, testBuilderConfig(), types.RelayerTxSafe, registry)
+	require.NoError(t, err)
+	assert.Equal(t, config, client.contractConfig)
+
+	_, err = NewRelayClient("https://example.test", 31337, nil, testBuilderConfig(), types.RelayerTxSafe)
+	require.ErrorIs(t, err, types.ErrConfigUnsupported)
+}
+
+func TestContractRegistry_RejectsInvalidEntries(t *testing.T) {
+	t.Parallel()
+
+	registry := NewContractRegistry()
+	err := registry.Register(1, types.ContractConfig{SafeContracts: types.SafeContractConfig{SafeFactory: "not-an-address"}})
+	require.Error(t, err)
+
+	err = registry.LoadJSON([]byte(`{"1": {"safeContracts": {"initCodeHash": "0x1234"}}, "2": {}}`))
+	require.Error(t, err)
+	assert.Empty(t, registry.Chains(), "a failed load registers nothing")
+
+	_, err = registry.Get(1)
+	require.ErrorIs(t, err, types.ErrConfigUnsupported)
+}
+
+func TestNewRelayClientWithRegistry_DerivesWithConfiguredInitCodeHash(t *testing.T) {
+	t.Parallel()
+
+	safeConfig := types.SafeContractConfig{
+		SafeFactory:   "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		SafeMultisend: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512",
+		InitCodeHash:  "0x1111111111111111111111111111111111111111111111111111111111111111",
+	}
+	registry := NewContractRegistry()
+	require.NoError(t, registry.Register(31337, types.ContractConfig{SafeContracts: safeConfig}))
+
+	s := newTestSigner()
+	client, err := NewRelayClientWithRegistry("https://example.test", 31337, s, testBuilderConfig(), types.RelayerTxSafe, registry)
+	require.NoError(t, err)
+
+	safe, err := client.getExpectedSafe()
+	require.NoError(t, err)
+	want, err := DeriveSafeAddressWithConfig(s.address.Hex(), safeConfig)
+	require.NoError(t, err)
+	assert.Equal(t, want, safe)
+
+	withDefaultHash, err := DeriveSafeAddressWithConfig(s.address.Hex(), types.SafeContractConfig{SafeFactory: safeConfig.SafeFactory})
+	require.NoError(t, err)
+	assert.NotEqual(t, withDefaultHash, safe)
+}
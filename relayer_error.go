@@ -0,0 +1,152 @@
+package relayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+)
+
+// RelayerAPIError is the structured form of a relayer JSON error payload.
+type RelayerAPIError struct {
+	// Message is the human readable error returned by the relayer.
+	Message string
+	// ErrorCode is the relayer's own error code, if it sent one.
+	ErrorCode string
+	// Field is the request field the relayer objected to, if any.
+	Field string
+	// Err is the SDK error the payload was classified as; nil when the reason is not recognised.
+	Err *sdkerrors.SDKError
+}
+
+func (e *RelayerAPIError) Error() string {
+	msg := "relayer error: " + e.Message
+	if e.ErrorCode != "" {
+		msg += fmt.Sprintf(" (code=%s)", e.ErrorCode)
+	}
+	if e.Field != "" {
+		msg += fmt.Sprintf(" (field=%s)", e.Field)
+	}
+	return msg
+}
+
+func (e *RelayerAPIError) Unwrap() error {
+	if e == nil || e.Err == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// relayerErrorRule maps relayer codes, offending fields or message phrases to an SDK error.
+// Phrases name the failure itself ("nonce too low") rather than a bare noun, so messages that
+// merely mention a signature or nonce are not misclassified.
+type relayerErrorRule struct {
+	err      *sdkerrors.SDKError
+	codes    []string
+	fields   []string
+	keywords []string
+}
+
+var relayerErrorRules = []relayerErrorRule{
+	{
+		err:      sdkerrors.ErrBadSignature,
+		codes:    []string{"INVALID_SIGNATURE", "BAD_SIGNATURE", "SIGNATURE_MISMATCH"},
+		fields:   []string{"signature", "signatureparams"},
+		keywords: []string{"invalid signature", "bad signature", "signature mismatch", "signature verification failed"},
+	},
+	{
+		err:      sdkerrors.ErrNonceMismatch,
+		codes:    []string{"INVALID_NONCE", "NONCE_MISMATCH", "NONCE_TOO_LOW", "NONCE_TOO_HIGH"},
+		fields:   []string{"nonce"},
+		keywords: []string{"invalid nonce", "nonce mismatch", "nonce too low", "nonce too high", "nonce already used"},
+	},
+	{
+		err:      sdkerrors.ErrUnsupportedWallet,
+		codes:    []string{"UNSUPPORTED_WALLET_TYPE", "INVALID_WALLET_TYPE"},
+		keywords: []string{"unsupported wallet type", "invalid wallet type"},
+	},
+	{
+		err:      sdkerrors.ErrUnknownProxy,
+		codes:    []string{"UNKNOWN_PROXY", "PROXY_NOT_FOUND", "INVALID_PROXY"},
+		fields:   []string{"proxywallet", "proxy"},
+		keywords: []string{"unknown proxy", "proxy not found", "invalid proxy", "proxy wallet not found"},
+	},
+}
+
+// parseRelayerAPIError decodes a relayer error body. It returns nil if the body is not a JSON error payload.
+func parseRelayerAPIError(body []byte) *RelayerAPIError {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil
+	}
+	// Some responses nest the payload under "error".
+	if nested, ok := raw["error"]; ok {
+		var inner map[string]json.RawMessage
+		if json.Unmarshal(nested, &inner) == nil {
+			raw = inner
+		}
+	}
+
+	apiErr := &RelayerAPIError{
+		Message:   firstJSONString(raw, "message", "error", "msg", "reason"),
+		ErrorCode: firstJSONString(raw, "code", "errorCode", "error_code"),
+		Field:     firstJSONString(raw, "field", "param"),
+	}
+	if apiErr.Message == "" && apiErr.ErrorCode == "" {
+		return nil
+	}
+	apiErr.Err = classifyRelayerAPIError(apiErr)
+	return apiErr
+}
+
+func classifyRelayerAPIError(apiErr *RelayerAPIError) *sdkerrors.SDKError {
+	code := strings.ToUpper(apiErr.ErrorCode)
+	field := strings.ToLower(apiErr.Field)
+	message := strings.ToLower(apiErr.Message)
+
+	for _, rule := range relayerErrorRules {
+		for _, c := range rule.codes {
+			if code == c {
+				return rule.err
+			}
+		}
+	}
+	for _, rule := range relayerErrorRules {
+		for _, f := range rule.fields {
+			if field == f {
+				return rule.err
+			}
+		}
+	}
+	for _, rule := range relayerErrorRules {
+		for _, k := range rule.keywords {
+			if strings.Contains(message, k) {
+				return rule.err
+			}
+		}
+	}
+	return nil
+}
+
+// firstJSONString returns the first key holding a string (or number) value.
+func firstJSONString(raw map[string]json.RawMessage, keys ...string) string {
+	for _, k := range keys {
+		v, ok := raw[k]
+		if !ok {
+			continue
+		}
+		var s string
+		if json.Unmarshal(v, &s) == nil {
+			if s = strings.TrimSpace(s); s != "" {
+				return s
+			}
+			continue
+		}
+		var n json.Number
+		if json.Unmarshal(v, &n) == nil {
+			return n.String()
+		}
+	}
+	return ""
+}
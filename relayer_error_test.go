@@ -0,0 +1,68 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/GoPolymarket/go-builder-relayer-client/pkg/errors"
+)
+
+func TestParseRelayerAPIError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		body    string
+		want    *sdkerrors.SDKError
+		message string
+		field   string
+	}{
+		{name: "code", body: `{"error":"bad request","code":"INVALID_NONCE"}`, want: sdkerrors.ErrNonceMismatch, message: "bad request"},
+		{name: "field", body: `{"message":"invalid value","field":"signature"}`, want: sdkerrors.ErrBadSignature, message: "invalid value", field: "signature"},
+		{name: "keyword", body: `{"error":"unsupported wallet type: FOO"}`, want: sdkerrors.ErrUnsupportedWallet, message: "unsupported wallet type: FOO"},
+		{name: "nested", body: `{"error":{"message":"unknown proxy 0xabc","code":42}}`, want: sdkerrors.ErrUnknownProxy, message: "unknown proxy 0xabc"},
+		{name: "unrecognised", body: `{"error":"something else"}`, message: "something else"},
+		{name: "signature mentioned", body: `{"error":"missing builder signature header"}`, message: "missing builder signature header"},
+		{name: "nonce mentioned", body: `{"error":"could not fetch nonce from upstream"}`, message: "could not fetch nonce from upstream"},
+		{name: "generic type", body: `{"error":"invalid type for field value","field":"type"}`, message: "invalid type for field value", field: "type"},
+		{name: "path ignored", body: `{"error":"internal error","path":"/submit"}`, message: "internal error"},
+		{name: "nonce phrase", body: `{"error":"nonce too low: expected 4"}`, want: sdkerrors.ErrNonceMismatch, message: "nonce too low: expected 4"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiErr := parseRelayerAPIError([]byte(tc.body))
+			require.NotNil(t, apiErr)
+			assert.Equal(t, tc.message, apiErr.Message)
+			assert.Equal(t, tc.field, apiErr.Field)
+			assert.Equal(t, tc.want, apiErr.Err)
+		})
+	}
+
+	assert.Nil(t, parseRelayerAPIError([]byte(`not json`)))
+	assert.Nil(t, parseRelayerAPIError([]byte(`{"ok":false}`)))
+}
+
+func TestHTTPClientDo_DecodesRelayerErrorBody(t *testing.T) {
+	t.Parallel()
+
+	base := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusBadRequest, `{"error":"invalid signature","field":"signature"}`, nil), nil
+	})}
+	client := NewHTTPClient(base)
+
+	err := client.Do(context.Background(), http.MethodPost, "https://relayer.test/submit", nil, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, sdkerrors.ErrBadSignature)
+	assert.ErrorIs(t, err, sdkerrors.ErrBadRequest)
+	assert.False(t, errors.Is(err, sdkerrors.ErrNonceMismatch))
+
+	var apiErr *RelayerAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "invalid signature", apiErr.Message)
+	assert.Equal(t, "signature", apiErr.Field)
+}
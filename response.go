@@ -19,35 +19,84 @@ func (r *ClientRelayerTransactionResponse) GetTransaction(ctx context.Context) (
 	return r.client.GetTransaction(ctx, r.TransactionID)
 }
 
+// Wait polls until the transaction is mined or confirmed.
+// It fails with a *types.TransactionFailedError once the transaction is FAILED or INVALID.
 func (r *ClientRelayerTransactionResponse) Wait(ctx context.Context) (*types.RelayerTransaction, error) {
-	return r.client.PollUntilState(
-		ctx,
-		r.TransactionID,
-		[]types.RelayerTransactionState{types.StateMined, types.StateConfirmed},
-		types.StateFailed,
-		100,
-		0,
-	)
+	return r.WaitWithOptions(ctx, WaitOptions{})
+}
+
+// WaitUntil polls until the transaction reaches threshold (StateExecuted, StateMined or StateConfirmed).
+func (r *ClientRelayerTransactionResponse) WaitUntil(ctx context.Context, threshold types.RelayerTransactionState) (*types.RelayerTransaction, error) {
+	return r.WaitWithOptions(ctx, WaitOptions{Until: threshold})
 }
 
 // WaitOptions configures polling behaviour for WaitWithOptions.
 type WaitOptions struct {
 	MaxPolls      int
 	PollFrequency time.Duration
+	// Strategy replaces the fixed PollFrequency schedule, e.g. PolygonPollStrategy().
+	Strategy PollStrategy
+	// Timeout bounds the total wait instead of a poll count; MaxPolls then defaults to unbounded.
+	Timeout time.Duration
+	// Until is the success threshold: StateExecuted, StateMined or StateConfirmed. Defaults to StateMined.
+	Until types.RelayerTransactionState
 }
 
 // WaitWithOptions polls until the transaction reaches a terminal state using the provided options.
+// Zero fields take the client's defaults set with WithWaitOptions.
 func (r *ClientRelayerTransactionResponse) WaitWithOptions(ctx context.Context, opts WaitOptions) (*types.RelayerTransaction, error) {
+	opts = opts.withDefaults(r.client.waitOptions)
 	maxPolls := opts.MaxPolls
-	if maxPolls <= 0 {
+	if maxPolls <= 0 && opts.Timeout <= 0 {
 		maxPolls = 100
 	}
-	return r.client.PollUntilState(
+	strategy := opts.Strategy
+	if strategy == nil {
+		pollFrequency := opts.PollFrequency
+		if pollFrequency < time.Second {
+			pollFrequency = 2 * time.Second
+		}
+		strategy = FixedPoll{Interval: pollFrequency}
+	}
+	return r.client.PollUntilStateWithOptions(
 		ctx,
 		r.TransactionID,
-		[]types.RelayerTransactionState{types.StateMined, types.StateConfirmed},
+		statesReaching(opts.Until),
 		types.StateFailed,
-		maxPolls,
-		opts.PollFrequency,
+		PollOptions{Strategy: strategy, MaxPolls: maxPolls, Timeout: opts.Timeout},
 	)
-}
\ No newline at end of file
+}
+
+// statesReaching lists the success-path states at or beyond threshold, defaulting to StateMined.
+func statesReaching(threshold types.RelayerTransactionState) []types.RelayerTransactionState {
+	if threshold == "" || !threshold.Reached(types.StateNew) {
+		threshold = types.StateMined
+	}
+	var states []types.RelayerTransactionState
+	for _, s := range []types.RelayerTransactionState{types.StateNew, types.StateExecuted, types.StateMined, types.StateConfirmed} {
+		if s.Reached(threshold) {
+			states = append(states, s)
+		}
+	}
+	return states
+}
+
+// withDefaults fills the zero fields of o from defaults.
+func (o WaitOptions) withDefaults(defaults WaitOptions) WaitOptions {
+	if o.MaxPolls <= 0 {
+		o.MaxPolls = defaults.MaxPolls
+	}
+	if o.PollFrequency <= 0 {
+		o.PollFrequency = defaults.PollFrequency
+	}
+	if o.Strategy == nil {
+		o.Strategy = defaults.Strategy
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = defaults.Timeout
+	}
+	if o.Until == "" {
+		o.Until = defaults.Until
+	}
+	return o
+}
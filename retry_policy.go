@@ -0,0 +1,105 @@
+package relayer
+
+import (
+	"context"
+	"net/http/httptrace"
+	"sync/atomic"
+)
+
+// RetryPolicy selects which failures HTTPClient.Do retries. A nil policy retries every
+// network error, 5xx and 429 response.
+type RetryPolicy struct {
+	// MaxRetries overrides the client's retry count when non-zero.
+	MaxRetries uint
+	// RetryConnectErrors retries network errors that happened before the request headers
+	// were written (DNS, dial, TLS), so the server cannot have seen the request.
+	RetryConnectErrors bool
+	// RetryNetworkErrors retries network errors after the request may have reached the server,
+	// including failures while reading the response.
+	RetryNetworkErrors bool
+	// RetryServerErrors retries 5xx responses.
+	RetryServerErrors bool
+	// RetryTooManyRequests retries 429 responses, honouring Retry-After.
+	RetryTooManyRequests bool
+}
+
+var (
+	// RetryAll retries every transient failure. It is the policy for read-only endpoints.
+	RetryAll = RetryPolicy{RetryConnectErrors: true, RetryNetworkErrors: true, RetryServerErrors: true, RetryTooManyRequests: true}
+	// RetryUnsent only retries failures where the relayer cannot have processed the request.
+	// It is the default policy for SubmitTransactionEndpoint.
+	RetryUnsent = RetryPolicy{RetryConnectErrors: true, RetryTooManyRequests: true}
+	// RetryNever disables retries.
+	RetryNever = RetryPolicy{}
+)
+
+// defaultRetryPolicies is the per-endpoint table a new RelayClient starts with.
+func defaultRetryPolicies() map[string]RetryPolicy {
+	return map[string]RetryPolicy{
+		SubmitTransactionEndpoint: RetryUnsent,
+	}
+}
+
+func (p *RetryPolicy) retryNetworkError(sent bool) bool {
+	if p == nil {
+		return true
+	}
+	if sent {
+		return p.RetryNetworkErrors
+	}
+	return p.RetryConnectErrors
+}
+
+func (p *RetryPolicy) retryStatus(status int) bool {
+	if p == nil {
+		return true
+	}
+	if status >= 500 {
+		return p.RetryServerErrors
+	}
+	return p.RetryTooManyRequests
+}
+
+// traceRequestSent returns a context that records whether the request headers were written.
+func traceRequestSent(ctx context.Context) (context.Context, *atomic.Bool) {
+	var sent atomic.Bool
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteHeaders: func() { sent.Store(true) },
+	}), &sent
+}
+
+type retryPolicyContextKey struct{}
+
+// ContextWithRetryPolicy overrides the RelayClient retry policy for every request made with ctx.
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// SetRetryPolicy sets the retry policy for a relayer endpoint path such as
+// SubmitTransactionEndpoint. Pass nil to retry every transient failure on that endpoint.
+// By default submissions are only retried when the relayer cannot have received them.
+func (c *RelayClient) SetRetryPolicy(endpoint string, policy *RetryPolicy) {
+	c.retryMu.Lock()
+	defer c.retryMu.Unlock()
+	if policy == nil {
+		delete(c.retryPolicies, endpoint)
+		return
+	}
+	if c.retryPolicies == nil {
+		c.retryPolicies = make(map[string]RetryPolicy)
+	}
+	c.retryPolicies[endpoint] = *policy
+}
+
+// retryPolicy resolves the policy for a request: a context override, then the endpoint table.
+func (c *RelayClient) retryPolicy(ctx context.Context, endpoint string) *RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy); ok {
+		return &policy
+	}
+	c.retryMu.RLock()
+	defer c.retryMu.RUnlock()
+	if policy, ok := c.retryPolicies[endpoint]; ok {
+		return &policy
+	}
+	return nil
+}
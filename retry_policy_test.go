@@ -0,0 +1,98 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryPolicyTestClient(t *testing.T, submitStatus func(n int) int) (*RelayClient, *fakeRelayer) {
+	t.Helper()
+
+	var nonceCalls, submitCalls int
+	return newFakeRelayer(t, map[string]http.HandlerFunc{
+		GetNonceEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			if nonceCalls++; nonceCalls == 1 {
+				respond(http.StatusBadGateway, `{"error":"upstream"}`)(w, r)
+				return
+			}
+			respond(http.StatusOK, `{"nonce":"3"}`)(w, r)
+		},
+		GetTransactionsEndpoint: respond(http.StatusOK, `[]`),
+		SubmitTransactionEndpoint: func(w http.ResponseWriter, r *http.Request) {
+			submitCalls++
+			if status := submitStatus(submitCalls); status != http.StatusOK {
+				respond(status, `{"error":"upstream"}`)(w, r)
+				return
+			}
+			respond(http.StatusOK, `{"transactionID":"tx-1","state":"STATE_NEW"}`)(w, r)
+		},
+	}, WithBaseDelay(time.Millisecond))
+}
+
+func TestRetryPolicy_SubmitIsNotRetriedAfterServerError(t *testing.T) {
+	t.Parallel()
+
+	client, fake := newRetryPolicyTestClient(t, func(int) int { return http.StatusBadGateway })
+
+	_, err := client.Execute(context.Background(), idempotencyTestTxns, "")
+	require.Error(t, err)
+	assert.Equal(t, 2, fake.Calls(GetNonceEndpoint), "read-only endpoints keep retrying")
+	assert.Equal(t, 1, fake.Calls(SubmitTransactionEndpoint))
+
+	ctx := ContextWithRetryPolicy(context.Background(), RetryAll)
+	_, err = client.Execute(ctx, idempotencyTestTxns, "")
+	require.Error(t, err)
+	assert.Equal(t, 1+int(defaultMaxRetries)+1, fake.Calls(SubmitTransactionEndpoint))
+}
+
+func TestRetryPolicy_IdempotencyKeyAllowsCheckedResubmit(t *testing.T) {
+	t.Parallel()
+
+	client, fake := newRetryPolicyTestClient(t, func(n int) int {
+		if n == 1 {
+			return http.StatusBadGateway
+		}
+		return http.StatusOK
+	})
+
+	resp, err := client.Execute(context.Background(), idempotencyTestTxns, "", WithIdempotencyKey("order-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "tx-1", resp.TransactionID)
+	assert.Equal(t, 2, fake.Calls(SubmitTransactionEndpoint))
+	assert.Equal(t, 2, fake.Calls(GetTransactionsEndpoint))
+}
+
+func TestRetryPolicy_ConnectErrorsAreDistinguished(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	addr := server.URL
+	server.Close()
+
+	for name, tc := range map[string]struct {
+		policy  RetryPolicy
+		retries int
+	}{
+		"unsent": {policy: RetryUnsent, retries: 2},
+		"never":  {policy: RetryNever, retries: 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			retries := 0
+			client := NewHTTPClient(nil, WithMaxRetries(2), WithBaseDelay(time.Millisecond), WithInterceptors(Interceptor{
+				OnRetry: func(context.Context, RequestInfo, time.Duration, error) { retries++ },
+			}))
+			policy := tc.policy
+			err := client.Do(context.Background(), http.MethodPost, addr+SubmitTransactionEndpoint, &RequestOptions{Body: []byte(`{}`), Retry: &policy}, nil)
+			require.Error(t, err)
+			assert.Equal(t, tc.retries, retries)
+		})
+	}
+}
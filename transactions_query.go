@@ -0,0 +1,254 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+// localCursorPrefix marks cursors produced by client-side pagination; they hold the ID of the
+// last transaction returned and are never sent to the relayer.
+const localCursorPrefix = "local:"
+
+// TransactionFilter selects relayer transactions. Zero fields match everything.
+type TransactionFilter struct {
+	States         []types.RelayerTransactionState
+	Type           types.TransactionType
+	From           string
+	ProxyAddress   string
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	MetadataPrefix string
+}
+
+// GetTransactionsOptions configures GetTransactionsWithOptions.
+type GetTransactionsOptions struct {
+	Filter TransactionFilter
+	// Cursor is the NextCursor of the previous page; empty starts from the first page.
+	Cursor string
+	// Limit bounds the page size. Zero returns everything the relayer sends in one page.
+	Limit int
+}
+
+// TransactionsPage is one page of GetTransactionsWithOptions results.
+type TransactionsPage struct {
+	Transactions []types.RelayerTransaction
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// GetTransactionsWithOptions lists the builder's relayer transactions. Filters and pagination
+// are sent as query parameters; filters are also applied locally, and when the relayer
+// returns an unpaginated list the page is cut locally after the last transaction of the
+// previous page, so results are the same either way.
+func (c *RelayClient) GetTransactionsWithOptions(ctx context.Context, opts GetTransactionsOptions) (*TransactionsPage, error) {
+	if opts.Limit < 0 {
+		return nil, fmt.Errorf("invalid limit: %d", opts.Limit)
+	}
+	txns, nextCursor, paginated, err := c.fetchTransactions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if paginated {
+		return &TransactionsPage{Transactions: txns, NextCursor: nextCursor}, nil
+	}
+
+	// The relayer returned the full list: paginate locally.
+	txns, err = transactionsAfterCursor(txns, opts.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	page := &TransactionsPage{Transactions: txns}
+	if opts.Limit > 0 && len(txns) > opts.Limit {
+		page.Transactions = txns[:opts.Limit]
+		page.NextCursor = localCursorPrefix + txns[opts.Limit-1].TransactionID
+	}
+	return page, nil
+}
+
+// fetchTransactions requests one page from the relayer and applies the filter locally.
+// paginated is false when the relayer returned its whole list.
+func (c *RelayClient) fetchTransactions(ctx context.Context, opts GetTransactionsOptions) ([]types.RelayerTransaction, string, bool, error) {
+	params := opts.Filter.queryParams()
+	if opts.Cursor != "" && !strings.HasPrefix(opts.Cursor, localCursorPrefix) {
+		params["cursor"] = opts.Cursor
+	}
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+
+	var raw json.RawMessage
+	if err := c.send(ctx, GetTransactionsEndpoint, "GET", &RequestOptions{Params: params}, &raw); err != nil {
+		return nil, "", false, err
+	}
+	txns, nextCursor, paginated, err := decodeTransactionsPage(raw)
+	if err != nil {
+		return nil, "", false, err
+	}
+	matched := make([]types.RelayerTransaction, 0, len(txns))
+	for _, txn := range txns {
+		if opts.Filter.Match(txn) {
+			matched = append(matched, txn)
+		}
+	}
+	return matched, nextCursor, paginated, nil
+}
+
+// transactionsAfterCursor drops the transactions up to and including the one a local cursor
+// points at. Other cursors were sent to the relayer and leave txns unchanged.
+func transactionsAfterCursor(txns []types.RelayerTransaction, cursor string) ([]types.RelayerTransaction, error) {
+	if !strings.HasPrefix(cursor, localCursorPrefix) {
+		return txns, nil
+	}
+	lastID := strings.TrimPrefix(cursor, localCursorPrefix)
+	if lastID == "" {
+		return nil, fmt.Errorf("invalid cursor: %q", cursor)
+	}
+	i := slices.IndexFunc(txns, func(txn types.RelayerTransaction) bool { return txn.TransactionID == lastID })
+	if i < 0 {
+		return nil, fmt.Errorf("invalid cursor: transaction %s is no longer listed", lastID)
+	}
+	return txns[i+1:], nil
+}
+
+// TransactionsSeq walks every page of GetTransactionsWithOptions lazily, starting at opts.Cursor.
+// When the relayer returns its whole list, it is fetched once and walked in memory.
+// Iteration stops after the first error, which is yielded with a zero transaction.
+func (c *RelayClient) TransactionsSeq(ctx context.Context, opts GetTransactionsOptions) iter.Seq2[types.RelayerTransaction, error] {
+	return func(yield func(types.RelayerTransaction, error) bool) {
+		if opts.Limit < 0 {
+			yield(types.RelayerTransaction{}, fmt.Errorf("invalid limit: %d", opts.Limit))
+			return
+		}
+		seen := map[string]struct{}{}
+		for {
+			txns, nextCursor, paginated, err := c.fetchTransactions(ctx, opts)
+			if err == nil && !paginated {
+				txns, err = transactionsAfterCursor(txns, opts.Cursor)
+				nextCursor = ""
+			}
+			if err != nil {
+				yield(types.RelayerTransaction{}, err)
+				return
+			}
+			for _, txn := range txns {
+				if !yield(txn, nil) {
+					return
+				}
+			}
+			if nextCursor == "" {
+				return
+			}
+			if _, repeated := seen[nextCursor]; repeated {
+				yield(types.RelayerTransaction{}, fmt.Errorf("relayer repeated cursor %q", nextCursor))
+				return
+			}
+			seen[nextCursor] = struct{}{}
+			opts.Cursor = nextCursor
+		}
+	}
+}
+
+// Match reports whether txn satisfies every set field of the filter.
+func (f TransactionFilter) Match(txn types.RelayerTransaction) bool {
+	if len(f.States) > 0 {
+		matched := false
+		for _, state := range f.States {
+			if txn.State == state {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.Type != "" && !strings.EqualFold(txn.Type, string(f.Type)) {
+		return false
+	}
+	if f.From != "" && !strings.EqualFold(txn.From, f.From) {
+		return false
+	}
+	if f.ProxyAddress != "" && !strings.EqualFold(txn.ProxyAddress, f.ProxyAddress) {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && !txn.CreatedAt.After(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !txn.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	return f.MetadataPrefix == "" || strings.HasPrefix(txn.Metadata, f.MetadataPrefix)
+}
+
+func (f TransactionFilter) queryParams() map[string]string {
+	params := map[string]string{}
+	if len(f.States) > 0 {
+		states := make([]string, len(f.States))
+		for i, state := range f.States {
+			states[i] = string(state)
+		}
+		params["state"] = strings.Join(states, ",")
+	}
+	if f.Type != "" {
+		params["type"] = string(f.Type)
+	}
+	if f.From != "" {
+		params["from"] = f.From
+	}
+	if f.ProxyAddress != "" {
+		params["proxyAddress"] = f.ProxyAddress
+	}
+	if !f.CreatedAfter.IsZero() {
+		params["createdAfter"] = f.CreatedAfter.UTC().Format(time.RFC3339)
+	}
+	if !f.CreatedBefore.IsZero() {
+		params["createdBefore"] = f.CreatedBefore.UTC().Format(time.RFC3339)
+	}
+	if f.MetadataPrefix != "" {
+		params["metadataPrefix"] = f.MetadataPrefix
+	}
+	return params
+}
+
+// decodeTransactionsPage accepts either a bare array or a paginated object such as
+// {"transactions": [...], "nextCursor": "..."}.
+func decodeTransactionsPage(raw json.RawMessage) ([]types.RelayerTransaction, string, bool, error) {
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" {
+		return nil, "", false, nil
+	}
+	if strings.HasPrefix(trimmed, "[") {
+		var txns []types.RelayerTransaction
+		if err := json.Unmarshal(raw, &txns); err != nil {
+			return nil, "", false, fmt.Errorf("decode response: %w", err)
+		}
+		return txns, "", false, nil
+	}
+
+	var page struct {
+		Transactions    []types.RelayerTransaction `json:"transactions"`
+		Data            []types.RelayerTransaction `json:"data"`
+		NextCursor      string                     `json:"nextCursor"`
+		NextCursorSnake string                     `json:"next_cursor"`
+	}
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return nil, "", false, fmt.Errorf("decode response: %w", err)
+	}
+	txns := page.Transactions
+	if txns == nil {
+		txns = page.Data
+	}
+	cursor := page.NextCursor
+	if cursor == "" {
+		cursor = page.NextCursorSnake
+	}
+	return txns, cursor, true, nil
+}
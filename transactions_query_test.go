@@ -0,0 +1,102 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestGetTransactionsWithOptions_FiltersAndPaginatesLocally(t *testing.T) {
+	t.Parallel()
+
+	var lastQuery string
+	var requests int
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		lastQuery = req.URL.RawQuery
+		requests++
+		return newResponse(http.StatusOK, `[
+			{"transactionID":"a","state":"STATE_MINED","type":"SAFE","metadata":"redeem 1","createdAt":"2026-01-01T00:00:00Z"},
+			{"transactionID":"b","state":"STATE_FAILED","type":"SAFE","metadata":"redeem 2","createdAt":"2026-01-02T00:00:00Z"},
+			{"transactionID":"c","state":"STATE_MINED","type":"PROXY","metadata":"redeem 3","createdAt":"2026-01-03T00:00:00Z"},
+			{"transactionID":"d","state":"STATE_CONFIRMED","type":"SAFE","metadata":"approve","createdAt":"2026-01-04T00:00:00Z"},
+			{"transactionID":"e","state":"STATE_MINED","type":"SAFE","metadata":"redeem 5","createdAt":"2026-01-05T00:00:00Z"}
+		]`, nil), nil
+	})}))
+
+	opts := GetTransactionsOptions{
+		Filter: TransactionFilter{
+			States:         []types.RelayerTransactionState{types.StateMined, types.StateConfirmed},
+			Type:           types.TransactionTypeSafe,
+			MetadataPrefix: "redeem",
+			CreatedAfter:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		Limit: 1,
+	}
+	var ids []string
+	for txn, err := range client.TransactionsSeq(context.Background(), opts) {
+		require.NoError(t, err)
+		ids = append(ids, txn.TransactionID)
+	}
+	assert.Equal(t, []string{"a", "e"}, ids)
+	assert.Equal(t, 1, requests, "an unpaginated list is fetched once")
+	assert.Contains(t, lastQuery, "state=STATE_MINED%2CSTATE_CONFIRMED")
+	assert.Contains(t, lastQuery, "limit=1")
+	assert.NotContains(t, lastQuery, "cursor")
+}
+
+func TestGetTransactionsWithOptions_LocalCursorSurvivesInserts(t *testing.T) {
+	t.Parallel()
+
+	list := `[{"transactionID":"a"},{"transactionID":"b"},{"transactionID":"c"}]`
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Empty(t, req.URL.Query().Get("cursor"))
+		return newResponse(http.StatusOK, list, nil), nil
+	})}))
+
+	page, err := client.GetTransactionsWithOptions(context.Background(), GetTransactionsOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 2)
+	assert.Equal(t, "b", page.Transactions[1].TransactionID)
+
+	// A new transaction is listed first before the next page is requested.
+	list = `[{"transactionID":"z"},{"transactionID":"a"},{"transactionID":"b"},{"transactionID":"c"}]`
+	page, err = client.GetTransactionsWithOptions(context.Background(), GetTransactionsOptions{Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 1)
+	assert.Equal(t, "c", page.Transactions[0].TransactionID)
+	assert.Empty(t, page.NextCursor)
+
+	_, err = client.GetTransactionsWithOptions(context.Background(), GetTransactionsOptions{Cursor: localCursorPrefix + "gone"})
+	assert.Error(t, err)
+}
+
+func TestGetTransactionsWithOptions_ServerCursor(t *testing.T) {
+	t.Parallel()
+
+	client := newPollTestClient()
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") == "" {
+			return newResponse(http.StatusOK, `{"transactions":[{"transactionID":"a"}],"nextCursor":"p2"}`, nil), nil
+		}
+		return newResponse(http.StatusOK, `{"transactions":[{"transactionID":"b"}],"nextCursor":""}`, nil), nil
+	})}))
+
+	page, err := client.GetTransactionsWithOptions(context.Background(), GetTransactionsOptions{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "p2", page.NextCursor)
+
+	var ids []string
+	for txn, err := range client.TransactionsSeq(context.Background(), GetTransactionsOptions{Limit: 1}) {
+		require.NoError(t, err)
+		ids = append(ids, txn.TransactionID)
+	}
+	assert.Equal(t, []string{"a", "b"}, ids)
+}
@@ -0,0 +1,197 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+const (
+	defaultWatchInterval    = 2 * time.Second
+	defaultWatchConcurrency = 8
+	defaultWatchBuffer      = 256
+)
+
+// WatchEvent reports a state change of a watched transaction.
+type WatchEvent struct {
+	TransactionID string
+	// Previous is empty on the first observation of a transaction.
+	Previous    types.RelayerTransactionState
+	State       types.RelayerTransactionState
+	Transaction *types.RelayerTransaction
+	// Err is set when polling the transaction failed, in which case State holds the last known
+	// state, or when the new state could not be recorded to the client's Journal.
+	Err error
+}
+
+// WatcherOptions configures a Watcher.
+type WatcherOptions struct {
+	// Interval is the delay between polling rounds. Defaults to 2s.
+	Interval time.Duration
+	// Concurrency bounds the number of in-flight GetTransaction calls per round. Defaults to 8.
+	Concurrency int
+	// Buffer is the capacity of the events channel. Defaults to 256.
+	Buffer int
+}
+
+// Watcher tracks many relayer transactions with a single shared polling loop and
+// publishes their state changes on a channel. Terminal transactions are dropped automatically.
+type Watcher struct {
+	client *RelayClient
+	opts   WatcherOptions
+	events chan WatchEvent
+
+	mu      sync.Mutex
+	tracked map[string]types.RelayerTransactionState
+	running bool
+}
+
+// NewWatcher creates a Watcher that polls through this client. Call Run to start it.
+func (c *RelayClient) NewWatcher(opts WatcherOptions) *Watcher {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultWatchInterval
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultWatchConcurrency
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = defaultWatchBuffer
+	}
+	return &Watcher{
+		client:  c,
+		opts:    opts,
+		events:  make(chan WatchEvent, opts.Buffer),
+		tracked: make(map[string]types.RelayerTransactionState),
+	}
+}
+
+// Watch starts tracking transaction IDs. IDs that are already tracked are ignored.
+func (w *Watcher) Watch(transactionIDs ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, id := range transactionIDs {
+		if id == "" {
+			continue
+		}
+		if _, ok := w.tracked[id]; !ok {
+			w.tracked[id] = ""
+		}
+	}
+}
+
+// Unwatch stops tracking a transaction ID.
+func (w *Watcher) Unwatch(transactionID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.tracked, transactionID)
+}
+
+// Len returns the number of tracked transactions.
+func (w *Watcher) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.tracked)
+}
+
+// Events returns the channel state changes are published on. It is closed when Run returns.
+func (w *Watcher) Events() <-chan WatchEvent {
+	return w.events
+}
+
+// Run polls all tracked transactions every Interval until ctx is done. It must be called once.
+func (w *Watcher) Run(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return nil
+	}
+	w.running = true
+	w.mu.Unlock()
+	defer close(w.events)
+
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+	for {
+		w.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll runs one round over a snapshot of the tracked transactions.
+func (w *Watcher) poll(ctx context.Context) {
+	w.mu.Lock()
+	ids := make([]string, 0, len(w.tracked))
+	for id := range w.tracked {
+		ids = append(ids, id)
+	}
+	w.mu.Unlock()
+
+	sem := make(chan struct{}, w.opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			w.pollOne(ctx, id)
+		}(id)
+	}
+	wg.Wait()
+}
+
+func (w *Watcher) pollOne(ctx context.Context, id string) {
+	txns, err := w.client.GetTransaction(ctx, id)
+	if ctx.Err() != nil {
+		return
+	}
+
+	w.mu.Lock()
+	previous, ok := w.tracked[id]
+	if !ok {
+		// Unwatched while the request was in flight.
+		w.mu.Unlock()
+		return
+	}
+	event := WatchEvent{TransactionID: id, Previous: previous, State: previous}
+	switch {
+	case err != nil:
+		event.Err = err
+	case len(txns) == 0 || txns[0].State == previous:
+		w.mu.Unlock()
+		return
+	default:
+		txn := txns[0]
+		event.State = txn.State
+		event.Transaction = &txn
+		if txn.State.IsTerminal() {
+			delete(w.tracked, id)
+		} else {
+			w.tracked[id] = txn.State
+		}
+	}
+	w.mu.Unlock()
+
+	if event.Transaction != nil {
+		if err := w.client.journalState(ctx, *event.Transaction); err != nil {
+			event.Err = fmt.Errorf("record transaction state: %w", err)
+		}
+	}
+
+	select {
+	case w.events <- event:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,68 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GoPolymarket/go-builder-relayer-client/pkg/types"
+)
+
+func TestWatcher_EmitsStateChangesUntilTerminal(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	polls := map[string]int{}
+	progress := map[string][]types.RelayerTransactionState{
+		"tx-a": {types.StateNew, types.StateNew, types.StateMined, types.StateConfirmed},
+		"tx-b": {types.StateInvalid},
+	}
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		id := req.URL.Query().Get("id")
+		mu.Lock()
+		states := progress[id]
+		n := polls[id]
+		polls[id]++
+		mu.Unlock()
+		if n >= len(states) {
+			n = len(states) - 1
+		}
+		body := fmt.Sprintf(`[{"transactionID":%q,"state":%q}]`, id, states[n])
+		return newResponse(http.StatusOK, body, nil), nil
+	})
+
+	client, err := NewRelayClient("https://example.test", 137, nil, testBuilderConfig(), types.RelayerTxSafe)
+	require.NoError(t, err)
+	client.SetHTTPClient(NewHTTPClient(&http.Client{Transport: transport}))
+
+	w := client.NewWatcher(WatcherOptions{Interval: 5 * time.Millisecond})
+	w.Watch("tx-a", "tx-b", "tx-a")
+	assert.Equal(t, 2, w.Len())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx) }()
+
+	seen := map[string][]types.RelayerTransactionState{}
+	for w.Len() > 0 || len(seen["tx-a"]) < 3 || len(seen["tx-b"]) < 1 {
+		select {
+		case ev := <-w.Events():
+			require.NoError(t, ev.Err)
+			seen[ev.TransactionID] = append(seen[ev.TransactionID], ev.State)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for events, seen=%v", seen)
+		}
+	}
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	assert.Equal(t, []types.RelayerTransactionState{types.StateNew, types.StateMined, types.StateConfirmed}, seen["tx-a"])
+	assert.Equal(t, []types.RelayerTransactionState{types.StateInvalid}, seen["tx-b"])
+}